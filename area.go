@@ -0,0 +1,177 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidAreaAmount = errors.New("invalid area amount")
+	ErrInvalidAreaUnit   = errors.New("invalid area unit")
+)
+
+type Area struct {
+	Amount float64  `json:"amount"`
+	Unit   UnitArea `json:"unit"`
+}
+
+func NewAreaFromString(s string) (*Area, error) {
+	s = normalizeUnicode(s)
+
+	var unit UnitArea
+	var maxl int
+	for _, u := range UnitAreaAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitAreaUnknown {
+		return nil, ErrInvalidAreaUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidAreaAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Area{Amount: amount, Unit: unit}, nil
+}
+
+func (s Area) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
+
+func (s Area) StringStyle(style SymbolStyle) string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.Symbol(style)
+}
+
+func (s *Area) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+// Convert is exact for whole factors, metric and imperial units are bridged by square inch.
+func (s Area) Convert(unit UnitArea) Area {
+	if v, ok := TryConvertExactArea(s.Amount, s.Unit, unit); ok {
+		return Area{Amount: v, Unit: unit}
+	}
+	f, _ := exactFactor(s.Unit, unit, unitAreaLadders[:], unitAreaBridges[:])
+	return Area{Amount: s.Amount * float64(f.Num) / float64(f.Den), Unit: unit}
+}
+
+// Add sums areas in unit of s, or in finer unit when both are in same ladder.
+func (s Area) Add(o Area) Area {
+	unit := s.Unit
+	for _, l := range unitAreaLadders {
+		if u, ok := l.finer(s.Unit, o.Unit); ok {
+			unit = u
+			break
+		}
+	}
+	return Area{Amount: s.Convert(unit).Amount + o.Convert(unit).Amount, Unit: unit}
+}
+
+// Sub subtracts areas in same unit as Add.
+func (s Area) Sub(o Area) Area { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number, e.g. count of items.
+func (s Area) Scale(k float64) Area { return Area{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 1m2 / 25dm2 is 4.
+func (s Area) Div(o Area) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
+// TryConvertExactArea converts along ladders and across metric to imperial bridge, e.g. 1ft2 is exactly 92903.04mm2.
+func TryConvertExactArea[T int32 | int64 | float32 | float64](amount T, from, to UnitArea) (v T, ok bool) {
+	for _, l := range unitAreaLadders {
+		if v, ok := convertByLadder(amount, from, to, l); ok {
+			return v, true
+		}
+	}
+	f, ok := exactFactor(from, to, unitAreaLadders[:], unitAreaBridges[:])
+	if !ok {
+		return 0, false
+	}
+	return convertByRational(amount, f)
+}
+
+type UnitArea uint8
+
+//go:generate go-enum-encoding -type=UnitArea -string
+const (
+	UnitAreaUnknown       UnitArea = iota // json:""
+	UnitSquareMilliMeters                 // json:"mm2"
+	UnitSquareCentiMeters                 // json:"cm2"
+	UnitSquareDeciMeters                  // json:"dm2"
+	UnitSquareMeters                      // json:"m2"
+	UnitAres                              // json:"a"
+	UnitHectares                          // json:"ha"
+	UnitSquareKiloMeters                  // json:"km2"
+	UnitSquareInches                      // json:"in2"
+	UnitSquareFeet                        // json:"ft2"
+	UnitSquareYards                       // json:"yd2"
+	UnitAcres                             // json:"ac"
+	UnitSquareMiles                       // json:"mi2"
+)
+
+func (s UnitArea) Symbol(style SymbolStyle) string {
+	if v, ok := unicodeSymbolsArea[s]; ok && style == SymbolStyleUnicode {
+		return v
+	}
+	return s.String()
+}
+
+var UnitAreaAll = [...]UnitArea{
+	UnitSquareMilliMeters,
+	UnitSquareCentiMeters,
+	UnitSquareDeciMeters,
+	UnitSquareMeters,
+	UnitAres,
+	UnitHectares,
+	UnitSquareKiloMeters,
+	UnitSquareInches,
+	UnitSquareFeet,
+	UnitSquareYards,
+	UnitAcres,
+	UnitSquareMiles,
+}
+
+// square ladders follow length ladders with squared multipliers.
+var unitAreaMeterLadder = ladder[UnitArea]{
+	{UnitSquareMilliMeters, 1},
+	{UnitSquareCentiMeters, 10 * 10},
+	{UnitSquareDeciMeters, 10 * 10},
+	{UnitSquareMeters, 10 * 10},
+	{UnitAres, 10 * 10},
+	{UnitHectares, 10 * 10},
+	{UnitSquareKiloMeters, 10 * 10},
+}
+
+// acre is 4840 square yards, 640 acres make square mile of 1760 * 1760 square yards.
+var unitAreaInchLadder = ladder[UnitArea]{
+	{UnitSquareInches, 1},
+	{UnitSquareFeet, 12 * 12},
+	{UnitSquareYards, 3 * 3},
+	{UnitAcres, 4840},
+	{UnitSquareMiles, 640},
+}
+
+var unitAreaLadders = [...]ladder[UnitArea]{
+	unitAreaMeterLadder,
+	unitAreaInchLadder,
+}
+
+// square of 1 in = 25.4 mm.
+var unitAreaBridges = [...]bridge[UnitArea]{
+	{from: UnitSquareInches, to: UnitSquareMilliMeters, factor: Rational{127 * 127, 5 * 5}},
+}
@@ -0,0 +1,112 @@
+package measurement
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleNewAreaFromString() {
+	v, _ := NewAreaFromString("2.5m²")
+	fmt.Println(v, v.Convert(UnitSquareCentiMeters).StringStyle(SymbolStyleUnicode))
+	// Output: 2.5m2 25000cm²
+}
+
+func TestArea(t *testing.T) {
+	tests := map[string]Area{
+		"1m2":    {Amount: 1, Unit: UnitSquareMeters},
+		"1mm2":   {Amount: 1, Unit: UnitSquareMilliMeters},
+		"2ha":    {Amount: 2, Unit: UnitHectares},
+		"0.5ac":  {Amount: 0.5, Unit: UnitAcres},
+		"120ft2": {Amount: 120, Unit: UnitSquareFeet},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewAreaFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("unicode", func(t *testing.T) {
+		for s, v := range map[string]Area{"3m²": {3, UnitSquareMeters}, "4㎡": {4, UnitSquareMeters}, "5ft²": {5, UnitSquareFeet}} {
+			if u, err := NewAreaFromString(s); err != nil || *u != v {
+				t.Error(s, u, err)
+			}
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewAreaFromString("5m"); !errors.Is(err, ErrInvalidAreaUnit) {
+			t.Error(err)
+		}
+		if _, err := NewAreaFromString("m2"); !errors.Is(err, ErrInvalidAreaAmount) {
+			t.Error(err)
+		}
+	})
+}
+
+func TestAreaConversion_Exact(t *testing.T) {
+	tests := [][2]Area{
+		{{0, UnitSquareMeters}, {0, UnitAcres}},
+		{{1, UnitSquareMeters}, {10000, UnitSquareCentiMeters}},
+		{{1, UnitHectares}, {10000, UnitSquareMeters}},
+		{{1, UnitSquareKiloMeters}, {100, UnitHectares}},
+		{{1, UnitAres}, {100, UnitSquareMeters}},
+		{{1, UnitSquareYards}, {9, UnitSquareFeet}},
+		{{1, UnitAcres}, {43560, UnitSquareFeet}},
+		{{1, UnitSquareMiles}, {640, UnitAcres}},
+		{{1, UnitSquareInches}, {645.16, UnitSquareMilliMeters}},
+		{{1, UnitSquareFeet}, {92903.04, UnitSquareMilliMeters}},
+		{{1, UnitAcres}, {4046.8564224, UnitSquareMeters}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-9*b.Amount || c.Unit != b.Unit {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-9 || c.Unit != a.Unit {
+			t.Error(c, a)
+		}
+	}
+}
+
+func TestTryConvertExactArea(t *testing.T) {
+	if v, ok := TryConvertExactArea[int64](25, UnitSquareInches, UnitSquareMilliMeters); !ok || v != 16129 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactArea[int64](1, UnitSquareInches, UnitSquareMilliMeters); ok {
+		t.Error("645.16mm2 is not whole", v)
+	}
+	if v, ok := TryConvertExactArea[int64](3, UnitHectares, UnitSquareMeters); !ok || v != 30000 {
+		t.Error(v, ok)
+	}
+}
+
+func TestParser_ParseArea(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Area{
+		"120 sq ft":      {120, UnitSquareFeet},
+		"120 sq. ft.":    {120, UnitSquareFeet},
+		"120 SQFT":       {120, UnitSquareFeet},
+		"12 m²":          {12, UnitSquareMeters},
+		"12 m2":          {12, UnitSquareMeters},
+		"12 square feet": {12, UnitSquareFeet},
+		"6 соток":        {6, UnitAres},
+		"2,5 га":         {2.5, UnitHectares},
+		"40 acres":       {40, UnitAcres},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseArea(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
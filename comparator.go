@@ -0,0 +1,92 @@
+package measurement
+
+import (
+	"math"
+	"strings"
+)
+
+// Comparator qualifies value, as in "<5mg" or "~250g".
+// Zero value is equality, which is same as value without qualifier.
+type Comparator uint8
+
+//go:generate go-enum-encoding -type=Comparator -string
+const (
+	ComparatorEqual          Comparator = iota // json:"="
+	ComparatorLess                             // json:"<"
+	ComparatorLessOrEqual                      // json:"<="
+	ComparatorGreaterOrEqual                   // json:">="
+	ComparatorGreater                          // json:">"
+	ComparatorApprox                           // json:"~"
+)
+
+// comparatorApproxTolerance is relative difference still matching ComparatorApprox.
+const comparatorApproxTolerance = 0.1
+
+// comparatorPrefixes are spellings of comparators, longer spellings go first so "<=" is not read as "<".
+var comparatorPrefixes = [...]struct {
+	prefix     string
+	comparator Comparator
+}{
+	{"approximately", ComparatorApprox},
+	{"not more than", ComparatorLessOrEqual},
+	{"not less than", ComparatorGreaterOrEqual},
+	{"greater than", ComparatorGreater},
+	{"less than", ComparatorLess},
+	{"more than", ComparatorGreater},
+	{"at least", ComparatorGreaterOrEqual},
+	{"at most", ComparatorLessOrEqual},
+	{"approx.", ComparatorApprox},
+	{"approx", ComparatorApprox},
+	{"around", ComparatorApprox},
+	{"about", ComparatorApprox},
+	{"above", ComparatorGreater},
+	{"below", ComparatorLess},
+	{"under", ComparatorLess},
+	{"up to", ComparatorLessOrEqual},
+	{"over", ComparatorGreater},
+	{"max.", ComparatorLessOrEqual},
+	{"max", ComparatorLessOrEqual},
+	{"min.", ComparatorGreaterOrEqual},
+	{"min", ComparatorGreaterOrEqual},
+	{"ca.", ComparatorApprox},
+	{"<=", ComparatorLessOrEqual},
+	{"=<", ComparatorLessOrEqual},
+	{">=", ComparatorGreaterOrEqual},
+	{"=>", ComparatorGreaterOrEqual},
+	{"≤", ComparatorLessOrEqual},
+	{"≥", ComparatorGreaterOrEqual},
+	{"≈", ComparatorApprox},
+	{"<", ComparatorLess},
+	{">", ComparatorGreater},
+	{"~", ComparatorApprox},
+	{"=", ComparatorEqual},
+}
+
+// cutComparator removes comparator in front of s.
+func cutComparator(s string) (Comparator, string) {
+	s = strings.TrimSpace(s)
+	for _, q := range comparatorPrefixes {
+		if len(s) >= len(q.prefix) && strings.EqualFold(s[:len(q.prefix)], q.prefix) {
+			return q.comparator, strings.TrimSpace(s[len(q.prefix):])
+		}
+	}
+	return ComparatorEqual, s
+}
+
+// compare checks that actual satisfies comparator with expected.
+func (s Comparator) compare(actual, expected float64) bool {
+	switch s {
+	case ComparatorLess:
+		return actual < expected
+	case ComparatorLessOrEqual:
+		return actual <= expected
+	case ComparatorGreaterOrEqual:
+		return actual >= expected
+	case ComparatorGreater:
+		return actual > expected
+	case ComparatorApprox:
+		return math.Abs(actual-expected) <= comparatorApproxTolerance*math.Abs(expected)
+	default:
+		return actual == expected
+	}
+}
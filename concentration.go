@@ -0,0 +1,203 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidConcentrationAmount = errors.New("invalid concentration amount")
+	ErrInvalidConcentrationUnit   = errors.New("invalid concentration unit")
+)
+
+// Concentration is amount of solute in solution, e.g. water report "5mg/l", beverage "0.5%v/v" or "250ppm".
+// Molar concentration needs molar mass of solute and is not modeled.
+type Concentration struct {
+	Amount float64           `json:"amount"`
+	Unit   UnitConcentration `json:"unit"`
+}
+
+// NewConcentrationFromString matches unit ignoring case, so "5 mg/L" and "0.9%W/V" are accepted.
+func NewConcentrationFromString(s string) (*Concentration, error) {
+	s = normalizeUnicode(s)
+
+	var unit UnitConcentration
+	var maxl int
+	for _, u := range UnitConcentrationAll {
+		if n := len(u.String()); len(s) >= n && strings.EqualFold(s[len(s)-n:], u.String()) && n > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitConcentrationUnknown {
+		return nil, ErrInvalidConcentrationUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidConcentrationAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Concentration{Amount: amount, Unit: unit}, nil
+}
+
+func (s Concentration) String() string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String()
+}
+
+func (s *Concentration) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+// Convert converts to unit of same kind, e.g. 5mg/l is 0.0005g/100ml.
+// It is not ok for other kind, that needs density, see ConvertDensity.
+func (s Concentration) Convert(unit UnitConcentration) (Concentration, bool) {
+	if s.Unit.Kind() != unit.Kind() {
+		return Concentration{}, false
+	}
+	return s.ConvertDensity(unit, Density{}, Density{})
+}
+
+// ConvertDensity converts to unit of any kind.
+// Mass and volume of solute are related by solute density, e.g. of ethanol for "%v/v" to "%w/v".
+// Mass and volume of solution are related by solution density, e.g. of water for "ppm" to "mg/l".
+// Density that conversion does not need is ignored and may be zero, it is not ok when needed density is zero or of unknown unit.
+func (s Concentration) ConvertDensity(unit UnitConcentration, solute, solution Density) (Concentration, bool) {
+	a, ok := unitConcentrationRatios[s.Unit]
+	if !ok {
+		return Concentration{}, false
+	}
+	b, ok := unitConcentrationRatios[unit]
+	if !ok {
+		return Concentration{}, false
+	}
+
+	n, ok := convertMassOrVolume(s.Amount, a.mass, a.volume, b.mass, b.volume, solute)
+	if !ok {
+		return Concentration{}, false
+	}
+	d, ok := convertMassOrVolume(a.per, a.perMass, a.perVolume, b.perMass, b.perVolume, solution)
+	if !ok {
+		return Concentration{}, false
+	}
+	return Concentration{Amount: n / d * b.per, Unit: unit}, true
+}
+
+// convertMassOrVolume converts amount of mass or volume unit, across by density when one is mass and other is volume.
+func convertMassOrVolume(amount float64, fromMass UnitMass, fromVolume UnitVolume, toMass UnitMass, toVolume UnitVolume, d Density) (float64, bool) {
+	isMass, toIsMass := fromMass != UnitMassUnknown, toMass != UnitMassUnknown
+	if _, ok := unitDensityRatios[d.Unit]; isMass != toIsMass && (!ok || !(d.Amount > 0) || math.IsInf(d.Amount, 1)) {
+		return 0, false
+	}
+
+	switch {
+	case isMass && toIsMass:
+		return Mass{Amount: amount, Unit: fromMass}.Convert(toMass).Amount, true
+	case isMass:
+		return d.Volume(Mass{Amount: amount, Unit: fromMass}, toVolume).Amount, true
+	case toIsMass:
+		return d.Mass(Volume{Amount: amount, Unit: fromVolume}, toMass).Amount, true
+	default:
+		return Volume{Amount: amount, Unit: fromVolume}.Convert(toVolume).Amount, true
+	}
+}
+
+type ConcentrationKind uint8
+
+//go:generate go-enum-encoding -type=ConcentrationKind -string
+const (
+	ConcentrationKindUnknown         ConcentrationKind = iota // json:""
+	ConcentrationKindMassPerVolume                            // json:"w/v"
+	ConcentrationKindVolumePerVolume                          // json:"v/v"
+	ConcentrationKindMassPerMass                              // json:"w/w"
+)
+
+type UnitConcentration uint8
+
+//go:generate go-enum-encoding -type=UnitConcentration -string
+const (
+	UnitConcentrationUnknown    UnitConcentration = iota // json:""
+	UnitMicrogramsPerLiter                               // json:"mcg/l"
+	UnitMilligramsPerLiter                               // json:"mg/l"
+	UnitMilligramsPerMilliLiter                          // json:"mg/ml"
+	UnitGramsPer100MilliLiters                           // json:"g/100ml"
+	UnitPercentMassPerVolume                             // json:"%w/v"
+	UnitMilliLitersPerLiter                              // json:"ml/l"
+	UnitPercentVolumePerVolume                           // json:"%v/v"
+	UnitMilligramsPerKilogram                            // json:"mg/kg"
+	UnitGramsPerKilogram                                 // json:"g/kg"
+	UnitGramsPer100Grams                                 // json:"g/100g"
+	UnitPercent                                          // json:"%"
+	UnitPartsPerMillion                                  // json:"ppm"
+	UnitPartsPerBillion                                  // json:"ppb"
+)
+
+// Kind is what ratio unit is, plain percent and parts per million are mass per mass.
+func (s UnitConcentration) Kind() ConcentrationKind {
+	r, ok := unitConcentrationRatios[s]
+	switch {
+	case !ok:
+		return ConcentrationKindUnknown
+	case r.mass != UnitMassUnknown && r.perVolume != UnitVolumeUnknown:
+		return ConcentrationKindMassPerVolume
+	case r.volume != UnitVolumeUnknown:
+		return ConcentrationKindVolumePerVolume
+	default:
+		return ConcentrationKindMassPerMass
+	}
+}
+
+var UnitConcentrationAll = [...]UnitConcentration{
+	UnitMicrogramsPerLiter,
+	UnitMilligramsPerLiter,
+	UnitMilligramsPerMilliLiter,
+	UnitGramsPer100MilliLiters,
+	UnitPercentMassPerVolume,
+	UnitMilliLitersPerLiter,
+	UnitPercentVolumePerVolume,
+	UnitMilligramsPerKilogram,
+	UnitGramsPerKilogram,
+	UnitGramsPer100Grams,
+	UnitPercent,
+	UnitPartsPerMillion,
+	UnitPartsPerBillion,
+}
+
+// concentrationRatio is mass or volume of solute in `per` mass or volume units of solution.
+type concentrationRatio struct {
+	mass      UnitMass
+	volume    UnitVolume
+	perMass   UnitMass
+	perVolume UnitVolume
+	per       float64
+}
+
+var unitConcentrationRatios = map[UnitConcentration]concentrationRatio{
+	UnitMicrogramsPerLiter:      {mass: UnitMicrograms, perVolume: UnitLiters, per: 1},
+	UnitMilligramsPerLiter:      {mass: UnitMilligrams, perVolume: UnitLiters, per: 1},
+	UnitMilligramsPerMilliLiter: {mass: UnitMilligrams, perVolume: UnitMilliLiters, per: 1},
+	UnitGramsPer100MilliLiters:  {mass: UnitGrams, perVolume: UnitMilliLiters, per: 100},
+	UnitPercentMassPerVolume:    {mass: UnitGrams, perVolume: UnitMilliLiters, per: 100},
+	UnitMilliLitersPerLiter:     {volume: UnitMilliLiters, perVolume: UnitLiters, per: 1},
+	UnitPercentVolumePerVolume:  {volume: UnitMilliLiters, perVolume: UnitMilliLiters, per: 100},
+	UnitMilligramsPerKilogram:   {mass: UnitMilligrams, perMass: UnitKilograms, per: 1},
+	UnitGramsPerKilogram:        {mass: UnitGrams, perMass: UnitKilograms, per: 1},
+	UnitGramsPer100Grams:        {mass: UnitGrams, perMass: UnitGrams, per: 100},
+	UnitPercent:                 {mass: UnitGrams, perMass: UnitGrams, per: 100},
+	UnitPartsPerMillion:         {mass: UnitMilligrams, perMass: UnitKilograms, per: 1},
+	UnitPartsPerBillion:         {mass: UnitMicrograms, perMass: UnitKilograms, per: 1},
+}
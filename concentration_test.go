@@ -0,0 +1,196 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleConcentration_ConvertDensity() {
+	v, _ := NewConcentrationFromString("250ppm")
+	water := Density{Amount: 1, Unit: UnitKiloGramsPerLiter}
+	c, _ := v.ConvertDensity(UnitMilligramsPerLiter, Density{}, water)
+	fmt.Println(v.Unit.Kind(), c)
+	// Output: w/w 250mg/l
+}
+
+func TestConcentration(t *testing.T) {
+	tests := map[string]Concentration{
+		"5mg/l":       {Amount: 5, Unit: UnitMilligramsPerLiter},
+		"0.5%":        {Amount: 0.5, Unit: UnitPercent},
+		"250ppm":      {Amount: 250, Unit: UnitPartsPerMillion},
+		"12ppb":       {Amount: 12, Unit: UnitPartsPerBillion},
+		"4.5%v/v":     {Amount: 4.5, Unit: UnitPercentVolumePerVolume},
+		"0.9%w/v":     {Amount: 0.9, Unit: UnitPercentMassPerVolume},
+		"10.6g/100ml": {Amount: 10.6, Unit: UnitGramsPer100MilliLiters},
+		"35g/100g":    {Amount: 35, Unit: UnitGramsPer100Grams},
+		"3mcg/l":      {Amount: 3, Unit: UnitMicrogramsPerLiter},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewConcentrationFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("when unit in other case, then parsed", func(t *testing.T) {
+		tests := map[string]Concentration{
+			"5 mg/L":  {Amount: 5, Unit: UnitMilligramsPerLiter},
+			"2mg/mL":  {Amount: 2, Unit: UnitMilligramsPerMilliLiter},
+			"0.9%W/V": {Amount: 0.9, Unit: UnitPercentMassPerVolume},
+			"250 PPM": {Amount: 250, Unit: UnitPartsPerMillion},
+		}
+		for s, v := range tests {
+			if u, err := NewConcentrationFromString(s); err != nil || *u != v {
+				t.Error(s, u, err)
+			}
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewConcentrationFromString("5"); !errors.Is(err, ErrInvalidConcentrationUnit) {
+			t.Error(err)
+		}
+		if _, err := NewConcentrationFromString("ppm"); !errors.Is(err, ErrInvalidConcentrationAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Concentration{Amount: 5, Unit: UnitMilligramsPerLiter})
+		if err != nil || string(b) != `{"amount":5,"unit":"mg/l"}` {
+			t.Error(string(b), err)
+		}
+		var v Concentration
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"%v/v"}`), &v); err != nil || v != (Concentration{3, UnitPercentVolumePerVolume}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestUnitConcentration_Kind(t *testing.T) {
+	tests := map[UnitConcentration]ConcentrationKind{
+		UnitMilligramsPerLiter:     ConcentrationKindMassPerVolume,
+		UnitGramsPer100MilliLiters: ConcentrationKindMassPerVolume,
+		UnitPercentVolumePerVolume: ConcentrationKindVolumePerVolume,
+		UnitMilliLitersPerLiter:    ConcentrationKindVolumePerVolume,
+		UnitPercent:                ConcentrationKindMassPerMass,
+		UnitPartsPerMillion:        ConcentrationKindMassPerMass,
+		UnitConcentrationUnknown:   ConcentrationKindUnknown,
+	}
+	for u, k := range tests {
+		if u.Kind() != k {
+			t.Error(u, u.Kind(), k)
+		}
+	}
+}
+
+func TestConcentrationConversion(t *testing.T) {
+	tests := [][2]Concentration{
+		{{5, UnitMilligramsPerLiter}, {0.0005, UnitGramsPer100MilliLiters}},
+		{{5, UnitMilligramsPerLiter}, {5000, UnitMicrogramsPerLiter}},
+		{{0.9, UnitPercentMassPerVolume}, {9, UnitMilligramsPerMilliLiter}},
+		{{4.5, UnitPercentVolumePerVolume}, {45, UnitMilliLitersPerLiter}},
+		{{0.5, UnitPercent}, {5000, UnitPartsPerMillion}},
+		{{1, UnitPartsPerMillion}, {1000, UnitPartsPerBillion}},
+		{{35, UnitGramsPer100Grams}, {350, UnitGramsPerKilogram}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c, ok := a.Convert(b.Unit); !ok || math.Abs(c.Amount-b.Amount) > 1e-9*b.Amount || c.Unit != b.Unit {
+			t.Error(c, b, ok)
+		}
+		if c, ok := b.Convert(a.Unit); !ok || math.Abs(c.Amount-a.Amount) > 1e-9*a.Amount || c.Unit != a.Unit {
+			t.Error(c, a, ok)
+		}
+	}
+
+	t.Run("when other kind, then density is needed", func(t *testing.T) {
+		if c, ok := (Concentration{250, UnitPartsPerMillion}).Convert(UnitMilligramsPerLiter); ok {
+			t.Error(c)
+		}
+		if c, ok := (Concentration{5, UnitPercentVolumePerVolume}).Convert(UnitPercentMassPerVolume); ok {
+			t.Error(c)
+		}
+	})
+}
+
+func TestConcentration_ConvertDensity(t *testing.T) {
+	ethanol := DensityTableDefault["ethanol"]
+	seawater := Density{Amount: 1.025, Unit: UnitKiloGramsPerLiter}
+
+	tests := []struct {
+		from             Concentration
+		to               Concentration
+		solute, solution Density
+	}{
+		{Concentration{5, UnitPercentVolumePerVolume}, Concentration{3.945, UnitPercentMassPerVolume}, ethanol, Density{}},
+		{Concentration{35000, UnitMilligramsPerLiter}, Concentration{34146.34, UnitPartsPerMillion}, Density{}, seawater},
+		{Concentration{5, UnitPercentVolumePerVolume}, Concentration{3.945, UnitPercent}, ethanol, DensityTableDefault["water"]},
+	}
+	for _, tc := range tests {
+		if c, ok := tc.from.ConvertDensity(tc.to.Unit, tc.solute, tc.solution); !ok || math.Abs(c.Amount-tc.to.Amount) > 1e-2 || c.Unit != tc.to.Unit {
+			t.Error(c, tc.to)
+		}
+		if c, ok := tc.to.ConvertDensity(tc.from.Unit, tc.solute, tc.solution); !ok || math.Abs(c.Amount-tc.from.Amount) > 1e-2 || c.Unit != tc.from.Unit {
+			t.Error(c, tc.from)
+		}
+	}
+
+	t.Run("when needed density is zero or invalid, then not ok", func(t *testing.T) {
+		tests := []struct {
+			from             Concentration
+			to               UnitConcentration
+			solute, solution Density
+		}{
+			{Concentration{5, UnitPercentVolumePerVolume}, UnitPercentMassPerVolume, Density{}, Density{}},
+			{Concentration{5, UnitPercentVolumePerVolume}, UnitPercentMassPerVolume, Density{Amount: 0.789}, Density{}},
+			{Concentration{5, UnitPercentVolumePerVolume}, UnitPercentMassPerVolume, Density{Amount: -1, Unit: UnitGramsPerMilliLiter}, Density{}},
+			{Concentration{5, UnitPercentMassPerVolume}, UnitPercentVolumePerVolume, Density{}, Density{}},
+			{Concentration{35000, UnitMilligramsPerLiter}, UnitPartsPerMillion, Density{}, Density{}},
+			{Concentration{250, UnitPartsPerMillion}, UnitMilligramsPerLiter, Density{}, Density{Amount: math.Inf(1), Unit: UnitKiloGramsPerLiter}},
+			{Concentration{5, UnitPercentVolumePerVolume}, UnitPercent, ethanol, Density{}},
+			{Concentration{5, UnitConcentrationUnknown}, UnitPercent, ethanol, seawater},
+		}
+		for _, tc := range tests {
+			if c, ok := tc.from.ConvertDensity(tc.to, tc.solute, tc.solution); ok {
+				t.Error(tc.from, tc.to, c)
+			}
+		}
+	})
+
+	t.Run("when density is not needed, then ignored", func(t *testing.T) {
+		if c, ok := (Concentration{5, UnitMilligramsPerLiter}).ConvertDensity(UnitGramsPer100MilliLiters, Density{}, Density{}); !ok || c != (Concentration{0.0005, UnitGramsPer100MilliLiters}) {
+			t.Error(c, ok)
+		}
+	})
+}
+
+func TestParser_ParseConcentration(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Concentration{
+		"5 mg/L":        {5, UnitMilligramsPerLiter},
+		"0.5%":          {0.5, UnitPercent},
+		"250 ppm":       {250, UnitPartsPerMillion},
+		"4.5% vol":      {4.5, UnitPercentVolumePerVolume},
+		"4.5 % ABV":     {4.5, UnitPercentVolumePerVolume},
+		"3 µg/l":        {3, UnitMicrogramsPerLiter},
+		"10,6 г/100 мл": {10.6, UnitGramsPer100MilliLiters},
+		"2 g/100 ml":    {2, UnitGramsPer100MilliLiters},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseConcentration(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package measurement
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidCookingQuantity = errors.New("invalid cooking quantity")
+
+// CookingQuantity is amount in recipe line, e.g. "a pinch of salt" or "two and a half cups flour".
+// Informal measures are approximate, their Comparator is ComparatorApprox.
+type CookingQuantity struct {
+	Volume     *QualifiedVolume `json:"volume,omitzero"`
+	Mass       *QualifiedMass   `json:"mass,omitzero"`
+	Ingredient string           `json:"ingredient,omitzero"`
+}
+
+// informalVolumes are conventional definitions of informal cooking measures on US customary ladder.
+var informalVolumes = map[string]VolumeRational{
+	"smidgen": {Amount: Rational{1, 32}, Unit: UnitTeaspoons},
+	"pinch":   {Amount: Rational{1, 16}, Unit: UnitTeaspoons},
+	"dash":    {Amount: Rational{1, 8}, Unit: UnitTeaspoons},
+	"stick":   {Amount: Rational{1, 2}, Unit: UnitCups},
+	"handful": {Amount: Rational{1, 2}, Unit: UnitCups},
+}
+
+// informalMasses are informal measures defined by weight.
+var informalMasses = map[string]Mass{
+	"knob": {Amount: 1, Unit: UnitOunces},
+}
+
+var numberWords = map[string]int64{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12, "dozen": 12,
+}
+
+var fractionWords = map[string]Rational{
+	"half": {1, 2}, "halves": {1, 2},
+	"third": {1, 3}, "thirds": {1, 3},
+	"quarter": {1, 4}, "quarters": {1, 4},
+	"eighth": {1, 8}, "eighths": {1, 8},
+}
+
+// NewCookingQuantityFromString parses number words and informal measures with English unit names,
+// e.g. "a pinch of salt", "two and a half cups", "half a cup of milk", "1 stick butter", "1 1/2 tbsp".
+func NewCookingQuantityFromString(s string) (*CookingQuantity, error) {
+	words := strings.Fields(strings.ToLower(strings.ReplaceAll(normalizeUnicode(s), "-", " ")))
+
+	amount, words, ok := cutAmountWords(words)
+	if !ok {
+		return nil, ErrInvalidCookingQuantity
+	}
+
+	var q CookingQuantity
+	if v, n, ok := cutInformalMeasure(words, amount); ok {
+		q, words = v, words[n:]
+	} else if v, n, ok := cutCookingUnit(words, amount); ok {
+		q, words = v, words[n:]
+	} else {
+		return nil, ErrInvalidCookingQuantity
+	}
+
+	if len(words) > 0 && words[0] == "of" {
+		words = words[1:]
+	}
+	q.Ingredient = strings.Join(words, " ")
+	return &q, nil
+}
+
+// cutAmountWords reads amount from start of words: "2", "1 1/2", "1½", "two", "two and a half", "three quarters", "half a".
+func cutAmountWords(words []string) (Rational, []string, bool) {
+	if len(words) == 0 {
+		return Rational{}, nil, false
+	}
+
+	if len(words) > 1 {
+		if v, err := NewRationalFromString(words[0] + " " + words[1]); err == nil && strings.ContainsFunc(words[1], isFractionRune) {
+			return v, words[2:], true
+		}
+	}
+	if v, err := NewRationalFromString(words[0]); err == nil {
+		return v, words[1:], true
+	}
+
+	if f, ok := fractionWords[words[0]]; ok {
+		// "half a cup", "half of a cup"
+		words = words[1:]
+		if len(words) > 0 && words[0] == "of" {
+			words = words[1:]
+		}
+		if len(words) > 0 && (words[0] == "a" || words[0] == "an") {
+			words = words[1:]
+		}
+		return f, words, true
+	}
+
+	n, ok := numberWords[words[0]]
+	if !ok {
+		return Rational{}, nil, false
+	}
+	whole, words := NewRational(n, 1), words[1:]
+
+	// "a dozen", "two dozen"
+	if len(words) > 0 && words[0] == "dozen" {
+		whole, words = NewRational(n*12, 1), words[1:]
+	}
+
+	if len(words) == 0 {
+		return whole, words, true
+	}
+
+	// "a half", "three quarters"
+	if f, ok := fractionWords[words[0]]; ok {
+		v, _ := whole.mul(f)
+		return v, words[1:], true
+	}
+
+	// "two and a half"
+	if len(words) > 2 && words[0] == "and" {
+		if k, ok := numberWords[words[1]]; ok {
+			if f, ok := fractionWords[words[2]]; ok {
+				f, _ = NewRational(k, 1).mul(f)
+				v, ok := whole.add(f)
+				return v, words[3:], ok
+			}
+		}
+	}
+
+	return whole, words, true
+}
+
+func cutInformalMeasure(words []string, amount Rational) (CookingQuantity, int, bool) {
+	if len(words) == 0 {
+		return CookingQuantity{}, 0, false
+	}
+	w := words[0]
+
+	for _, singular := range []string{w, strings.TrimSuffix(w, "s"), strings.TrimSuffix(w, "es")} {
+		if v, ok := informalVolumes[singular]; ok {
+			a, ok := amount.mul(v.Amount)
+			if !ok {
+				return CookingQuantity{}, 0, false
+			}
+			return CookingQuantity{Volume: &QualifiedVolume{Comparator: ComparatorApprox, Amount: a.Float64(), Unit: v.Unit}}, 1, true
+		}
+		if v, ok := informalMasses[singular]; ok {
+			return CookingQuantity{Mass: &QualifiedMass{Comparator: ComparatorApprox, Amount: amount.Float64() * v.Amount, Unit: v.Unit}}, 1, true
+		}
+	}
+
+	return CookingQuantity{}, 0, false
+}
+
+// cutCookingUnit reads unit name of up to three words, longer names first, so "fluid ounces" is not "fluid".
+func cutCookingUnit(words []string, amount Rational) (CookingQuantity, int, bool) {
+	for n := min(3, len(words)); n > 0; n-- {
+		s := "1 " + strings.Join(words[:n], " ")
+		if v, err := ExtractorParser.ParseVolume(s); err == nil {
+			return CookingQuantity{Volume: &QualifiedVolume{Amount: amount.Float64(), Unit: v.Unit}}, n, true
+		}
+		if v, err := ExtractorParser.ParseMass(s); err == nil {
+			return CookingQuantity{Mass: &QualifiedMass{Amount: amount.Float64(), Unit: v.Unit}}, n, true
+		}
+	}
+	return CookingQuantity{}, 0, false
+}
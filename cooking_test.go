@@ -0,0 +1,72 @@
+package measurement
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleNewCookingQuantityFromString() {
+	for _, s := range []string{"a pinch of salt", "two and a half cups flour", "1 stick butter"} {
+		q, _ := NewCookingQuantityFromString(s)
+		fmt.Println(q.Volume, q.Ingredient)
+	}
+	// Output:
+	// ~0.0625tsp salt
+	// 2.5cup flour
+	// ~0.5cup butter
+}
+
+func TestNewCookingQuantityFromString(t *testing.T) {
+	tests := map[string]CookingQuantity{
+		"a pinch of salt":            {Volume: &QualifiedVolume{ComparatorApprox, 0.0625, UnitTeaspoons}, Ingredient: "salt"},
+		"2 pinches salt":             {Volume: &QualifiedVolume{ComparatorApprox, 0.125, UnitTeaspoons}, Ingredient: "salt"},
+		"a dash":                     {Volume: &QualifiedVolume{ComparatorApprox, 0.125, UnitTeaspoons}},
+		"a dash of Tabasco":          {Volume: &QualifiedVolume{ComparatorApprox, 0.125, UnitTeaspoons}, Ingredient: "tabasco"},
+		"1 stick butter":             {Volume: &QualifiedVolume{ComparatorApprox, 0.5, UnitCups}, Ingredient: "butter"},
+		"two sticks of butter":       {Volume: &QualifiedVolume{ComparatorApprox, 1, UnitCups}, Ingredient: "butter"},
+		"a handful":                  {Volume: &QualifiedVolume{ComparatorApprox, 0.5, UnitCups}},
+		"a smidgen":                  {Volume: &QualifiedVolume{ComparatorApprox, 0.03125, UnitTeaspoons}},
+		"a knob of butter":           {Mass: &QualifiedMass{ComparatorApprox, 1, UnitOunces}, Ingredient: "butter"},
+		"two and a half cups":        {Volume: &QualifiedVolume{ComparatorEqual, 2.5, UnitCups}},
+		"one and three quarters cup": {Volume: &QualifiedVolume{ComparatorEqual, 1.75, UnitCups}},
+		"three-quarters cup sugar":   {Volume: &QualifiedVolume{ComparatorEqual, 0.75, UnitCups}, Ingredient: "sugar"},
+		"half a cup of milk":         {Volume: &QualifiedVolume{ComparatorEqual, 0.5, UnitCups}, Ingredient: "milk"},
+		"a half cup":                 {Volume: &QualifiedVolume{ComparatorEqual, 0.5, UnitCups}},
+		"1 1/2 tbsp olive oil":       {Volume: &QualifiedVolume{ComparatorEqual, 1.5, UnitTablespoons}, Ingredient: "olive oil"},
+		"1½ cups":                    {Volume: &QualifiedVolume{ComparatorEqual, 1.5, UnitCups}},
+		"8 fluid ounces water":       {Volume: &QualifiedVolume{ComparatorEqual, 8, UnitFluidOunces}, Ingredient: "water"},
+		"two pounds potatoes":        {Mass: &QualifiedMass{ComparatorEqual, 2, UnitPounds}, Ingredient: "potatoes"},
+		"a dozen grams":              {Mass: &QualifiedMass{ComparatorEqual, 12, UnitGrams}},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			q, err := NewCookingQuantityFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if q.Ingredient != v.Ingredient || (q.Volume == nil) != (v.Volume == nil) || (q.Mass == nil) != (v.Mass == nil) {
+				t.Fatal(q, v)
+			}
+			if (q.Volume != nil && *q.Volume != *v.Volume) || (q.Mass != nil && *q.Mass != *v.Mass) {
+				t.Error(q.Volume, q.Mass)
+			}
+		})
+	}
+
+	t.Run("informal measures are on US ladder", func(t *testing.T) {
+		for name, v := range informalVolumes {
+			if _, ok := v.Convert(UnitTeaspoons); !ok || unitVolumeUSALadder.indexOf(v.Unit) == -1 {
+				t.Error(name, v)
+			}
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		for _, s := range []string{"", "salt", "a bunch of parsley", "two", "1/0 cup"} {
+			if _, err := NewCookingQuantityFromString(s); !errors.Is(err, ErrInvalidCookingQuantity) {
+				t.Error(s, err)
+			}
+		}
+	})
+}
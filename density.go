@@ -0,0 +1,220 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidDensityAmount = errors.New("invalid density amount")
+	ErrInvalidDensityUnit   = errors.New("invalid density unit")
+	ErrUnknownSubstance     = errors.New("unknown substance")
+)
+
+// Density is mass per volume of substance, e.g. milk "1.03kg/l".
+type Density struct {
+	Amount float64     `json:"amount"`
+	Unit   UnitDensity `json:"unit"`
+}
+
+func NewDensityFromString(s string) (*Density, error) {
+	s = normalizeUnicode(s)
+
+	var unit UnitDensity
+	var maxl int
+	for _, u := range UnitDensityAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitDensityUnknown {
+		return nil, ErrInvalidDensityUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidDensityAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Density{Amount: amount, Unit: unit}, nil
+}
+
+func (s Density) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
+
+func (s Density) StringStyle(style SymbolStyle) string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.Symbol(style)
+}
+
+func (s *Density) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+// Convert converts mass per one volume unit, as Mass.Convert and Volume.Convert do.
+func (s Density) Convert(unit UnitDensity) Density {
+	if v, ok := TryConvertExactDensity(s.Amount, s.Unit, unit); ok {
+		return Density{Amount: v, Unit: unit}
+	}
+	a, b := unitDensityRatios[s.Unit], unitDensityRatios[unit]
+	m := Mass{Amount: s.Amount, Unit: a.mass}.Convert(b.mass).Amount
+	v := Volume{Amount: 1, Unit: a.volume}.Convert(b.volume).Amount
+	return Density{Amount: m / v, Unit: unit}
+}
+
+// TryConvertExactDensity converts mass and volume units along their ladders, e.g. 1000kg/m3 is exactly 1g/cm3.
+func TryConvertExactDensity[T int32 | int64 | float32 | float64](amount T, from, to UnitDensity) (v T, ok bool) {
+	a, ok := unitDensityRatios[from]
+	if !ok {
+		return 0, false
+	}
+	b, ok := unitDensityRatios[to]
+	if !ok {
+		return 0, false
+	}
+
+	v, ok = TryConvertExactMass(amount, a.mass, b.mass)
+	if !ok {
+		return 0, false
+	}
+	// mass in one `a` volume unit is mass in as many `b` volume units as are in one `a` volume unit
+	return TryConvertExactVolume(v, b.volume, a.volume)
+}
+
+// Volume is volume of mass of substance, it is approximate as density depends on temperature and packing.
+func (s Density) Volume(m Mass, unit UnitVolume) QualifiedVolume {
+	r := unitDensityRatios[s.Unit]
+	v := Volume{Amount: m.Convert(r.mass).Amount / s.Amount, Unit: r.volume}.Convert(unit)
+	return QualifiedVolume{Comparator: ComparatorApprox, Amount: v.Amount, Unit: unit}
+}
+
+// Mass is mass of volume of substance, it is approximate as Volume is.
+func (s Density) Mass(v Volume, unit UnitMass) QualifiedMass {
+	r := unitDensityRatios[s.Unit]
+	m := Mass{Amount: v.Convert(r.volume).Amount * s.Amount, Unit: r.mass}.Convert(unit)
+	return QualifiedMass{Comparator: ComparatorApprox, Amount: m.Amount, Unit: unit}
+}
+
+// DensityTable is densities of substances by name, names are matched ignoring case.
+// Tables are plain maps, so applications extend DensityTableDefault or make own.
+type DensityTable map[string]Density
+
+// Density finds density of substance, "Milk" and " milk " are "milk".
+func (s DensityTable) Density(substance string) (Density, error) {
+	k := strings.ToLower(strings.TrimSpace(substance))
+	for name, d := range s {
+		if strings.ToLower(name) == k {
+			return d, nil
+		}
+	}
+	return Density{}, ErrUnknownSubstance
+}
+
+// Volume is volume of mass of substance, e.g. 120g of flour is about 1 cup.
+func (s DensityTable) Volume(substance string, m Mass, unit UnitVolume) (*QualifiedVolume, error) {
+	d, err := s.Density(substance)
+	if err != nil {
+		return nil, err
+	}
+	v := d.Volume(m, unit)
+	return &v, nil
+}
+
+// Mass is mass of volume of substance, e.g. 1l of milk is about 1.03kg.
+func (s DensityTable) Mass(substance string, v Volume, unit UnitMass) (*QualifiedMass, error) {
+	d, err := s.Density(substance)
+	if err != nil {
+		return nil, err
+	}
+	m := d.Mass(v, unit)
+	return &m, nil
+}
+
+// DensityTableDefault has typical densities of liquids and of dry ingredients as measured by US cup in recipes.
+var DensityTableDefault = DensityTable{
+	"water":          {1, UnitGramsPerMilliLiter},
+	"milk":           {1.03, UnitKiloGramsPerLiter},
+	"heavy cream":    {0.994, UnitGramsPerMilliLiter},
+	"orange juice":   {1.04, UnitGramsPerMilliLiter},
+	"olive oil":      {0.911, UnitGramsPerMilliLiter},
+	"vegetable oil":  {0.92, UnitGramsPerMilliLiter},
+	"honey":          {1.42, UnitGramsPerMilliLiter},
+	"maple syrup":    {1.32, UnitGramsPerMilliLiter},
+	"vinegar":        {1.01, UnitGramsPerMilliLiter},
+	"ethanol":        {0.789, UnitGramsPerMilliLiter},
+	"gasoline":       {0.745, UnitKiloGramsPerLiter},
+	"diesel":         {0.832, UnitKiloGramsPerLiter},
+	"flour":          {0.507, UnitGramsPerMilliLiter}, // 120g per cup
+	"sugar":          {0.845, UnitGramsPerMilliLiter}, // 200g per cup
+	"brown sugar":    {0.93, UnitGramsPerMilliLiter},  // 220g per cup
+	"powdered sugar": {0.507, UnitGramsPerMilliLiter}, // 120g per cup
+	"butter":         {0.959, UnitGramsPerMilliLiter}, // 227g per cup
+	"salt":           {1.217, UnitGramsPerMilliLiter}, // 288g per cup
+	"rice":           {0.782, UnitGramsPerMilliLiter}, // 185g per cup
+	"rolled oats":    {0.38, UnitGramsPerMilliLiter},  // 90g per cup
+	"cocoa powder":   {0.423, UnitGramsPerMilliLiter}, // 100g per cup
+}
+
+type UnitDensity uint8
+
+//go:generate go-enum-encoding -type=UnitDensity -string
+const (
+	UnitDensityUnknown          UnitDensity = iota // json:""
+	UnitKiloGramsPerCubicMeter                     // json:"kg/m3"
+	UnitGramsPerCubicCentiMeter                    // json:"g/cm3"
+	UnitGramsPerMilliLiter                         // json:"g/ml"
+	UnitKiloGramsPerLiter                          // json:"kg/l"
+	UnitGramsPerLiter                              // json:"g/l"
+	UnitPoundsPerGallon                            // json:"lb/gal"
+	UnitPoundsPerCubicFoot                         // json:"lb/ft3"
+)
+
+func (s UnitDensity) Symbol(style SymbolStyle) string {
+	if v, ok := unicodeSymbolsDensity[s]; ok && style == SymbolStyleUnicode {
+		return v
+	}
+	return s.String()
+}
+
+// Ratio is mass and volume units that define density unit.
+func (s UnitDensity) Ratio() (UnitMass, UnitVolume) {
+	r := unitDensityRatios[s]
+	return r.mass, r.volume
+}
+
+var UnitDensityAll = [...]UnitDensity{
+	UnitKiloGramsPerCubicMeter,
+	UnitGramsPerCubicCentiMeter,
+	UnitGramsPerMilliLiter,
+	UnitKiloGramsPerLiter,
+	UnitGramsPerLiter,
+	UnitPoundsPerGallon,
+	UnitPoundsPerCubicFoot,
+}
+
+type densityRatio struct {
+	mass   UnitMass
+	volume UnitVolume
+}
+
+var unitDensityRatios = map[UnitDensity]densityRatio{
+	UnitKiloGramsPerCubicMeter:  {UnitKilograms, UnitCubicMeters},
+	UnitGramsPerCubicCentiMeter: {UnitGrams, UnitCubicCentiMeters},
+	UnitGramsPerMilliLiter:      {UnitGrams, UnitMilliLiters},
+	UnitKiloGramsPerLiter:       {UnitKilograms, UnitLiters},
+	UnitGramsPerLiter:           {UnitGrams, UnitLiters},
+	UnitPoundsPerGallon:         {UnitPounds, UnitGallons},
+	UnitPoundsPerCubicFoot:      {UnitPounds, UnitCubicFeet},
+}
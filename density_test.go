@@ -0,0 +1,156 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleDensityTable_Mass() {
+	m, _ := DensityTableDefault.Mass("milk", Volume{Amount: 1, Unit: UnitLiters}, UnitKilograms)
+	fmt.Println(m)
+	// Output: ~1.03kg
+}
+
+func TestDensity(t *testing.T) {
+	tests := map[string]Density{
+		"1000kg/m3":  {Amount: 1000, Unit: UnitKiloGramsPerCubicMeter},
+		"1.03kg/l":   {Amount: 1.03, Unit: UnitKiloGramsPerLiter},
+		"0.92g/ml":   {Amount: 0.92, Unit: UnitGramsPerMilliLiter},
+		"7.8g/cm3":   {Amount: 7.8, Unit: UnitGramsPerCubicCentiMeter},
+		"250g/l":     {Amount: 250, Unit: UnitGramsPerLiter},
+		"8.34lb/gal": {Amount: 8.34, Unit: UnitPoundsPerGallon},
+		"62.4lb/ft3": {Amount: 62.4, Unit: UnitPoundsPerCubicFoot},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewDensityFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("unicode", func(t *testing.T) {
+		v := Density{Amount: 1000, Unit: UnitKiloGramsPerCubicMeter}
+		if s := v.StringStyle(SymbolStyleUnicode); s != "1000kg/m³" {
+			t.Error(s)
+		}
+		if u, err := NewDensityFromString("1000kg/m³"); err != nil || *u != v {
+			t.Error(u, err)
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewDensityFromString("5"); !errors.Is(err, ErrInvalidDensityUnit) {
+			t.Error(err)
+		}
+		if _, err := NewDensityFromString("g/ml"); !errors.Is(err, ErrInvalidDensityAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Density{Amount: 2, Unit: UnitKiloGramsPerLiter})
+		if err != nil || string(b) != `{"amount":2,"unit":"kg/l"}` {
+			t.Error(string(b), err)
+		}
+		var v Density
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"g/cm3"}`), &v); err != nil || v != (Density{3, UnitGramsPerCubicCentiMeter}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestDensityConversion(t *testing.T) {
+	tests := [][2]Density{
+		{{1000, UnitKiloGramsPerCubicMeter}, {1, UnitGramsPerMilliLiter}},
+		{{1, UnitKiloGramsPerLiter}, {1000, UnitGramsPerLiter}},
+		{{1, UnitGramsPerCubicCentiMeter}, {1000, UnitKiloGramsPerCubicMeter}},
+		{{1, UnitGramsPerMilliLiter}, {8.345, UnitPoundsPerGallon}},
+		{{1000, UnitKiloGramsPerCubicMeter}, {62.43, UnitPoundsPerCubicFoot}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-3*b.Amount || c.Unit != b.Unit {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-3*a.Amount || c.Unit != a.Unit {
+			t.Error(c, a)
+		}
+	}
+
+	if v, ok := TryConvertExactDensity[int64](1000, UnitKiloGramsPerCubicMeter, UnitGramsPerCubicCentiMeter); !ok || v != 1 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactDensity[int64](3, UnitKiloGramsPerLiter, UnitGramsPerLiter); !ok || v != 3000 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactDensity[int64](1, UnitPoundsPerGallon, UnitGramsPerLiter); ok {
+		t.Error("pound is approximate", v)
+	}
+}
+
+func TestDensity_Volume(t *testing.T) {
+	d := Density{Amount: 1.03, Unit: UnitKiloGramsPerLiter}
+	if v := d.Volume(Mass{Amount: 2.06, Unit: UnitKilograms}, UnitMilliLiters); v.Comparator != ComparatorApprox || math.Abs(v.Amount-2000) > 1e-9 || v.Unit != UnitMilliLiters {
+		t.Error(v)
+	}
+	if m := d.Mass(Volume{Amount: 500, Unit: UnitMilliLiters}, UnitGrams); m.Comparator != ComparatorApprox || math.Abs(m.Amount-515) > 1e-9 || m.Unit != UnitGrams {
+		t.Error(m)
+	}
+}
+
+func TestDensityTable(t *testing.T) {
+	t.Run("cup of flour", func(t *testing.T) {
+		m, err := DensityTableDefault.Mass("Flour", Volume{Amount: 1, Unit: UnitCups}, UnitGrams)
+		if err != nil || m.Comparator != ComparatorApprox || math.Abs(m.Amount-120) > 1 {
+			t.Error(m, err)
+		}
+		v, err := DensityTableDefault.Volume(" flour ", Mass{Amount: 240, Unit: UnitGrams}, UnitCups)
+		if err != nil || v.Comparator != ComparatorApprox || math.Abs(v.Amount-2) > 0.01 {
+			t.Error(v, err)
+		}
+	})
+
+	t.Run("when own table, then its densities", func(t *testing.T) {
+		table := DensityTable{"sand": {1.6, UnitKiloGramsPerLiter}}
+		if m, err := table.Mass("sand", Volume{Amount: 10, Unit: UnitLiters}, UnitKilograms); err != nil || math.Abs(m.Amount-16) > 1e-9 {
+			t.Error(m, err)
+		}
+		if _, err := table.Density("milk"); !errors.Is(err, ErrUnknownSubstance) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("when unknown substance, then error", func(t *testing.T) {
+		if _, err := DensityTableDefault.Volume("unobtainium", Mass{Amount: 1, Unit: UnitGrams}, UnitLiters); !errors.Is(err, ErrUnknownSubstance) {
+			t.Error(err)
+		}
+	})
+}
+
+func TestParser_ParseDensity(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Density{
+		"1.03 kg/l":              {1.03, UnitKiloGramsPerLiter},
+		"1.03 KG/L":              {1.03, UnitKiloGramsPerLiter},
+		"997 kg/m³":              {997, UnitKiloGramsPerCubicMeter},
+		"0,92 г/мл":              {0.92, UnitGramsPerMilliLiter},
+		"8.34 pounds per gallon": {8.34, UnitPoundsPerGallon},
+		"2.7 g/cc":               {2.7, UnitGramsPerCubicCentiMeter},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseDensity(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
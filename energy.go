@@ -0,0 +1,184 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidEnergyAmount = errors.New("invalid energy amount")
+	ErrInvalidEnergyUnit   = errors.New("invalid energy unit")
+)
+
+// Energy is amount of energy, e.g. nutrition "250kcal" or battery "75kWh".
+type Energy struct {
+	Amount float64    `json:"amount"`
+	Unit   UnitEnergy `json:"unit"`
+}
+
+func NewEnergyFromString(s string) (*Energy, error) {
+	s = normalizeUnicode(s)
+
+	var unit UnitEnergy
+	var maxl int
+	for _, u := range UnitEnergyAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitEnergyUnknown {
+		return nil, ErrInvalidEnergyUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidEnergyAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Energy{Amount: amount, Unit: unit}, nil
+}
+
+func (s Energy) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
+
+func (s *Energy) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+// Convert is exact between joules, watt-hours and calories, British thermal units are approximate.
+func (s Energy) Convert(unit UnitEnergy) Energy {
+	if v, ok := TryConvertExactEnergy(s.Amount, s.Unit, unit); ok {
+		return Energy{Amount: v, Unit: unit}
+	}
+	return Energy{Amount: convertEnergyApproxFromJoule(convertEnergyApproxToJoule(s.Amount, s.Unit), unit), Unit: unit}
+}
+
+// Add sums energies in unit of s, or in finer unit when both are in same ladder, so 1kJ + 500J is exactly 1500J.
+func (s Energy) Add(o Energy) Energy {
+	unit := s.Unit
+	for _, l := range unitEnergyLadders {
+		if u, ok := l.finer(s.Unit, o.Unit); ok {
+			unit = u
+			break
+		}
+	}
+	return Energy{Amount: s.Convert(unit).Amount + o.Convert(unit).Amount, Unit: unit}
+}
+
+// Sub subtracts energies in same unit as Add.
+func (s Energy) Sub(o Energy) Energy { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number, e.g. count of servings.
+func (s Energy) Scale(k float64) Energy { return Energy{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 1kWh / 250Wh is 4.
+func (s Energy) Div(o Energy) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
+// TryConvertExactEnergy converts along ladders and across joule bridges, e.g. 1kcal is exactly 4184J.
+func TryConvertExactEnergy[T int32 | int64 | float32 | float64](amount T, from, to UnitEnergy) (v T, ok bool) {
+	for _, l := range unitEnergyLadders {
+		if v, ok := convertByLadder(amount, from, to, l); ok {
+			return v, true
+		}
+	}
+	f, ok := exactFactor(from, to, unitEnergyLadders[:], unitEnergyBridges[:])
+	if !ok {
+		return 0, false
+	}
+	return convertByRational(amount, f)
+}
+
+type UnitEnergy uint8
+
+//go:generate go-enum-encoding -type=UnitEnergy -string
+const (
+	UnitEnergyUnknown       UnitEnergy = iota // json:""
+	UnitJoules                                // json:"J"
+	UnitKiloJoules                            // json:"kJ"
+	UnitMegaJoules                            // json:"MJ"
+	UnitCalories                              // json:"cal"
+	UnitKiloCalories                          // json:"kcal"
+	UnitWattHours                             // json:"Wh"
+	UnitKiloWattHours                         // json:"kWh"
+	UnitBritishThermalUnits                   // json:"BTU"
+	UnitTherms                                // json:"thm"
+)
+
+var UnitEnergyAll = [...]UnitEnergy{
+	UnitJoules,
+	UnitKiloJoules,
+	UnitMegaJoules,
+	UnitCalories,
+	UnitKiloCalories,
+	UnitWattHours,
+	UnitKiloWattHours,
+	UnitBritishThermalUnits,
+	UnitTherms,
+}
+
+var unitEnergyJouleLadder = ladder[UnitEnergy]{
+	{UnitJoules, 1},
+	{UnitKiloJoules, 1000},
+	{UnitMegaJoules, 1000},
+}
+
+var unitEnergyCalorieLadder = ladder[UnitEnergy]{
+	{UnitCalories, 1},
+	{UnitKiloCalories, 1000},
+}
+
+var unitEnergyWattHourLadder = ladder[UnitEnergy]{
+	{UnitWattHours, 1},
+	{UnitKiloWattHours, 1000},
+}
+
+// US therm is 100000 BTU.
+var unitEnergyBritishThermalUnitLadder = ladder[UnitEnergy]{
+	{UnitBritishThermalUnits, 1},
+	{UnitTherms, 100000},
+}
+
+var unitEnergyLadders = [...]ladder[UnitEnergy]{
+	unitEnergyJouleLadder,
+	unitEnergyCalorieLadder,
+	unitEnergyWattHourLadder,
+	unitEnergyBritishThermalUnitLadder,
+}
+
+// watt-hour is 3600 J, thermochemical calorie used on food labels is defined as exactly 4.184 J.
+var unitEnergyBridges = [...]bridge[UnitEnergy]{
+	{from: UnitWattHours, to: UnitJoules, factor: Rational{3600, 1}},
+	{from: UnitCalories, to: UnitJoules, factor: Rational{523, 125}},
+}
+
+// International Table BTU, it is not whole number of joules.
+const jouleMulApproxUnitBritishThermalUnits = 1055.05585262
+
+func convertEnergyApproxToJoule[T float32 | float64](amount T, unit UnitEnergy) T {
+	if v, ok := convertByLadderApprox(amount, unit, UnitBritishThermalUnits, unitEnergyBritishThermalUnitLadder); ok {
+		return v * jouleMulApproxUnitBritishThermalUnits
+	}
+	v, _ := TryConvertExactEnergy(amount, unit, UnitJoules)
+	return v
+}
+
+func convertEnergyApproxFromJoule[T float32 | float64](amount T, unit UnitEnergy) T {
+	if v, ok := convertByLadderApprox(amount/jouleMulApproxUnitBritishThermalUnits, UnitBritishThermalUnits, unit, unitEnergyBritishThermalUnitLadder); ok {
+		return v
+	}
+	v, _ := TryConvertExactEnergy(amount, UnitJoules, unit)
+	return v
+}
@@ -0,0 +1,162 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleNewEnergyFromString() {
+	v, _ := NewEnergyFromString("250kcal")
+	fmt.Println(v.Amount, v.Unit, v.Convert(UnitKiloJoules))
+	// Output: 250 kcal 1046kJ
+}
+
+func TestEnergy(t *testing.T) {
+	tests := map[string]Energy{
+		"250kcal":  {Amount: 250, Unit: UnitKiloCalories},
+		"1046kJ":   {Amount: 1046, Unit: UnitKiloJoules},
+		"75kWh":    {Amount: 75, Unit: UnitKiloWattHours},
+		"12000BTU": {Amount: 12000, Unit: UnitBritishThermalUnits},
+		"1.5thm":   {Amount: 1.5, Unit: UnitTherms},
+		"5J":       {Amount: 5, Unit: UnitJoules},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewEnergyFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("when space before unit, then same", func(t *testing.T) {
+		for s, v := range map[string]Energy{"250 kcal": {250, UnitKiloCalories}, "1046 kJ": {1046, UnitKiloJoules}} {
+			if u, err := NewEnergyFromString(s); err != nil || *u != v {
+				t.Error(s, u, err)
+			}
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewEnergyFromString("5"); !errors.Is(err, ErrInvalidEnergyUnit) {
+			t.Error(err)
+		}
+		if _, err := NewEnergyFromString("kcal"); !errors.Is(err, ErrInvalidEnergyAmount) {
+			t.Error(err)
+		}
+		if _, err := NewEnergyFromString(" kcal"); !errors.Is(err, ErrInvalidEnergyAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Energy{Amount: 2, Unit: UnitKiloWattHours})
+		if err != nil || string(b) != `{"amount":2,"unit":"kWh"}` {
+			t.Error(string(b), err)
+		}
+		var v Energy
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"kcal"}`), &v); err != nil || v != (Energy{3, UnitKiloCalories}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestEnergyConversion_Exact(t *testing.T) {
+	tests := [][2]Energy{
+		{{0, UnitJoules}, {0, UnitTherms}},
+		{{1, UnitMegaJoules}, {1000000, UnitJoules}},
+		{{1, UnitKiloCalories}, {4184, UnitJoules}},
+		{{250, UnitKiloCalories}, {1046, UnitKiloJoules}},
+		{{1, UnitKiloWattHours}, {3.6, UnitMegaJoules}},
+		{{1, UnitWattHours}, {3600, UnitJoules}},
+		{{1, UnitTherms}, {100000, UnitBritishThermalUnits}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); c != b {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-12 || c.Unit != a.Unit {
+			t.Error(c, a)
+		}
+	}
+}
+
+func TestEnergyConversion_Approx(t *testing.T) {
+	tests := [][2]Energy{
+		{{1, UnitBritishThermalUnits}, {1055.05585262, UnitJoules}},
+		{{1, UnitTherms}, {105.505585262, UnitMegaJoules}},
+		{{1, UnitKiloWattHours}, {3412.14163, UnitBritishThermalUnits}},
+		{{1, UnitBritishThermalUnits}, {252.1644007, UnitCalories}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-3 || c.Unit != b.Unit {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-6 || c.Unit != a.Unit {
+			t.Error(c, a)
+		}
+	}
+
+	t.Run("when kcal to therms, then not zero", func(t *testing.T) {
+		for i := 1; i <= 1000; i++ {
+			v := Energy{Amount: float64(i), Unit: UnitKiloCalories}
+			want := v.Amount * 4184 / 105505585.262
+			if c := v.Convert(UnitTherms); math.Abs(c.Amount-want) > 1e-9*want || c.Unit != UnitTherms {
+				t.Fatal(v, c)
+			}
+		}
+	})
+}
+
+func TestTryConvertExactEnergy(t *testing.T) {
+	if v, ok := TryConvertExactEnergy[int64](1, UnitKiloCalories, UnitJoules); !ok || v != 4184 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactEnergy[int64](1, UnitCalories, UnitJoules); ok {
+		t.Error("4.184J is not whole", v)
+	}
+	if v, ok := TryConvertExactEnergy[int64](1, UnitBritishThermalUnits, UnitJoules); ok {
+		t.Error("BTU is approximate", v)
+	}
+	if v, ok := TryConvertExactEnergy[int32](1000, UnitKiloWattHours, UnitJoules); ok {
+		t.Error("overflow", v)
+	}
+}
+
+func TestEnergy_Add(t *testing.T) {
+	if v := (Energy{1, UnitKiloJoules}).Add(Energy{500, UnitJoules}); v != (Energy{1500, UnitJoules}) {
+		t.Error(v)
+	}
+	if v := (Energy{1, UnitKiloCalories}).Add(Energy{1, UnitKiloJoules}); math.Abs(v.Amount-(1+1000/4184.0)) > 1e-12 || v.Unit != UnitKiloCalories {
+		t.Error(v)
+	}
+}
+
+func TestParser_ParseEnergy(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Energy{
+		"250 kcal":         {250, UnitKiloCalories},
+		"1046 kJ":          {1046, UnitKiloJoules},
+		"1046 KJ":          {1046, UnitKiloJoules},
+		"2,5 кВт·ч":        {2.5, UnitKiloWattHours},
+		"120 Cal":          {120, UnitKiloCalories},
+		"9000 BTUs":        {9000, UnitBritishThermalUnits},
+		"3 kilowatt hours": {3, UnitKiloWattHours},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseEnergy(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	ErrInvalidExpression = errors.New("invalid expression")
+	ErrDimensionMismatch = errors.New("dimension mismatch")
+	ErrDivisionByZero    = errors.New("division by zero")
+)
+
+// ExpressionError is error at byte offset Pos of expression.
+type ExpressionError struct {
+	Pos int
+	Err error
+}
+
+func (e *ExpressionError) Error() string { return e.Err.Error() + " at " + strconv.Itoa(e.Pos) }
+
+func (e *ExpressionError) Unwrap() error { return e.Err }
+
+// Eval evaluates expression with ExtractorParser, e.g. "2kg + 500g - 120g tare" or "6*330ml".
+func Eval(s string) (*Quantity, error) { return ExtractorParser.Eval(s) }
+
+// Eval evaluates sums and differences of masses or volumes, multiplied or divided by numbers, with parentheses.
+// Words after literal are annotation and ignored, as "tare" in "120g tare".
+// Units are kept exact as in Mass.Add, so result is in finest unit of ladder used.
+func (p Parser) Eval(s string) (*Quantity, error) {
+	e := expression{p: p, s: s, symbols: p.scanSymbols()}
+
+	v, err := e.sum()
+	if err != nil {
+		return nil, err
+	}
+	if e.skipSpaces(); e.i < len(s) {
+		return nil, e.errorf(e.i, ErrInvalidExpression)
+	}
+	if v.Type == MeasureTypeUndefined {
+		return nil, e.errorf(0, ErrInvalidQuantity)
+	}
+	return &v.Quantity, nil
+}
+
+// operand is quantity or number, number has undefined type.
+type operand struct {
+	Quantity
+	number float64
+}
+
+type expression struct {
+	p       Parser
+	s       string
+	i       int
+	symbols []scanSymbol
+}
+
+func (e *expression) errorf(pos int, err error) error { return &ExpressionError{Pos: pos, Err: err} }
+
+func (e *expression) skipSpaces() { e.i = skipSpaces(e.s, e.i) }
+
+// peek is next operator or parenthesis, or 0.
+func (e *expression) peek() byte {
+	if e.skipSpaces(); e.i < len(e.s) {
+		return e.s[e.i]
+	}
+	return 0
+}
+
+func (e *expression) sum() (operand, error) {
+	v, err := e.product()
+	if err != nil {
+		return operand{}, err
+	}
+
+	for op := e.peek(); op == '+' || op == '-'; op = e.peek() {
+		pos := e.i
+		e.i++
+
+		o, err := e.product()
+		if err != nil {
+			return operand{}, err
+		}
+		if op == '-' {
+			if o, err = e.scale(o, -1, pos); err != nil {
+				return operand{}, err
+			}
+		}
+
+		if v.Type == MeasureTypeUndefined && o.Type == MeasureTypeUndefined {
+			v.number += o.number
+			continue
+		}
+		q, ok := v.add(o.Quantity)
+		if !ok {
+			return operand{}, e.errorf(pos, ErrDimensionMismatch)
+		}
+		v = operand{Quantity: q}
+	}
+
+	return v, nil
+}
+
+func (e *expression) product() (operand, error) {
+	v, err := e.unary()
+	if err != nil {
+		return operand{}, err
+	}
+
+	for op := e.peek(); op == '*' || op == '/'; op = e.peek() {
+		pos := e.i
+		e.i++
+
+		o, err := e.unary()
+		if err != nil {
+			return operand{}, err
+		}
+
+		switch {
+		case o.Type != MeasureTypeUndefined && (op == '/' || v.Type != MeasureTypeUndefined):
+			return operand{}, e.errorf(pos, ErrDimensionMismatch)
+		case o.Type != MeasureTypeUndefined:
+			v, err = e.scale(o, v.number, pos)
+		case op == '/' && o.number == 0:
+			return operand{}, e.errorf(pos, ErrDivisionByZero)
+		case op == '/':
+			v, err = e.scale(v, 1/o.number, pos)
+		default:
+			v, err = e.scale(v, o.number, pos)
+		}
+		if err != nil {
+			return operand{}, err
+		}
+	}
+
+	return v, nil
+}
+
+func (e *expression) unary() (operand, error) {
+	if e.peek() == '-' {
+		pos := e.i
+		e.i++
+		v, err := e.unary()
+		if err != nil {
+			return operand{}, err
+		}
+		return e.scale(v, -1, pos)
+	}
+	return e.primary()
+}
+
+func (e *expression) primary() (operand, error) {
+	if e.peek() == '(' {
+		pos := e.i
+		e.i++
+		v, err := e.sum()
+		if err != nil {
+			return operand{}, err
+		}
+		if e.peek() != ')' {
+			if e.i == len(e.s) {
+				return operand{}, e.errorf(pos, ErrInvalidExpression)
+			}
+			return operand{}, e.errorf(e.i, ErrInvalidExpression)
+		}
+		e.i++
+		return v, nil
+	}
+
+	if e.i == len(e.s) || !isNumberStart(e.s, e.i) {
+		return operand{}, e.errorf(e.i, ErrInvalidExpression)
+	}
+
+	var v operand
+	if m, ok := e.p.scanMeasure(e.s, e.i, e.symbols); ok {
+		v.Quantity = Quantity{Type: m.Type, Mass: m.Mass, Volume: m.Volume}
+		e.i = m.End
+	} else {
+		n := scanNumber(e.s, e.i)
+		amount, ok := parseAmount(e.s[e.i:n], e.p.formats(), e.p.Grouping)
+		if !ok {
+			return operand{}, e.errorf(e.i, ErrInvalidExpression)
+		}
+		v.number, e.i = amount, n
+	}
+
+	e.skipAnnotation()
+	return v, nil
+}
+
+// skipAnnotation skips words after literal up to next operator.
+// Word right after literal that is unit is not skipped, so "3 m" is not read as number 3.
+func (e *expression) skipAnnotation() {
+	for j, first := skipSpaces(e.s, e.i), true; j < len(e.s); first = false {
+		r, size := utf8.DecodeRuneInString(e.s[j:])
+		if !unicode.IsLetter(r) {
+			return
+		}
+		k := j
+		for k < len(e.s) && (unicode.IsLetter(r) || r == '\'' || (r == '-' && continuesWord(e.s, k))) {
+			k += size
+			r, size = utf8.DecodeRuneInString(e.s[k:])
+		}
+		if first {
+			if _, err := e.p.Interpretations("1" + e.s[j:k]); err == nil {
+				return
+			}
+		}
+		e.i, j = k, skipSpaces(e.s, k)
+	}
+}
+
+// scale multiplies v by number, quantity that is not scaled is mismatch at operator pos.
+func (e *expression) scale(v operand, k float64, pos int) (operand, error) {
+	if v.Type == MeasureTypeUndefined {
+		return operand{number: v.number * k}, nil
+	}
+	q, ok := v.Quantity.scale(k)
+	if !ok {
+		return operand{}, e.errorf(pos, ErrDimensionMismatch)
+	}
+	return operand{Quantity: q}, nil
+}
@@ -0,0 +1,90 @@
+package measurement
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleEval() {
+	q, _ := Eval("2kg + 500g - 120g tare")
+	fmt.Println(q)
+	// Output: 2380g
+}
+
+func TestEval(t *testing.T) {
+	tests := map[string]Quantity{
+		"2kg + 500g - 120g tare": {Type: MeasureTypeMass, Mass: &Mass{2380, UnitGrams}},
+		"6*330ml":                {Type: MeasureTypeVolume, Volume: &Volume{1980, UnitMilliLiters}},
+		"330ml * 6":              {Type: MeasureTypeVolume, Volume: &Volume{1980, UnitMilliLiters}},
+		"3 * 330 ml":             {Type: MeasureTypeVolume, Volume: &Volume{990, UnitMilliLiters}},
+		"1l / 4":                 {Type: MeasureTypeVolume, Volume: &Volume{0.25, UnitLiters}},
+		"2kg-500g":               {Type: MeasureTypeMass, Mass: &Mass{1500, UnitGrams}},
+		"(2 + 1) * (100g + 1kg)": {Type: MeasureTypeMass, Mass: &Mass{3300, UnitGrams}},
+		"-1kg + 2kg":             {Type: MeasureTypeMass, Mass: &Mass{1, UnitKilograms}},
+		"2 * -1kg":               {Type: MeasureTypeMass, Mass: &Mass{-2, UnitKilograms}},
+		"1 cup + 2 tbsp":         {Type: MeasureTypeVolume, Volume: &Volume{18, UnitTablespoons}},
+		"12 fl oz * 2":           {Type: MeasureTypeVolume, Volume: &Volume{24, UnitFluidOunces}},
+		"1kg gross - 50g box":    {Type: MeasureTypeMass, Mass: &Mass{950, UnitGrams}},
+		" 5kg ":                  {Type: MeasureTypeMass, Mass: &Mass{5, UnitKilograms}},
+		"1 ½ cups * 2":           {Type: MeasureTypeVolume, Volume: &Volume{3, UnitCups}},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			q, err := Eval(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if q.Type != v.Type || q.String() != v.String() {
+				t.Error(q, v)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error at position", func(t *testing.T) {
+		tests := []struct {
+			s   string
+			pos int
+			err error
+		}{
+			{"2kg + 330ml", 4, ErrDimensionMismatch},
+			{"2kg * 2kg", 4, ErrDimensionMismatch},
+			{"2 / 1kg", 2, ErrDimensionMismatch},
+			{"2kg / 0", 4, ErrDivisionByZero},
+			{"2kg +", 5, ErrInvalidExpression},
+			{"2kg + + 1kg", 6, ErrInvalidExpression},
+			{"(2kg + 1kg", 0, ErrInvalidExpression},
+			{"(2kg + 1kg))", 11, ErrInvalidExpression},
+			{"2kg 1kg", 4, ErrInvalidExpression},
+			{"2 * 3", 0, ErrInvalidQuantity},
+			{"2kg * 3 m", 8, ErrInvalidExpression},
+			{"3m", 1, ErrInvalidExpression},
+			{"1l / 2 km", 7, ErrInvalidExpression},
+			{"", 0, ErrInvalidExpression},
+		}
+		for _, tt := range tests {
+			_, err := Eval(tt.s)
+			var e *ExpressionError
+			if !errors.As(err, &e) || e.Pos != tt.pos || !errors.Is(err, tt.err) {
+				t.Error(tt.s, err)
+			}
+		}
+	})
+
+	t.Run("when quantity is not scaled, then mismatch", func(t *testing.T) {
+		for _, q := range []Quantity{
+			{Type: MeasureTypeTemperature, Temperature: &Temperature{20, UnitCelsius}},
+			{Type: MeasureTypeDensity, Density: &Density{1, UnitKiloGramsPerLiter}},
+			{Type: MeasureTypeConcentration, Concentration: &Concentration{5, UnitPercent}},
+		} {
+			if _, ok := q.scale(2); ok {
+				t.Error(q)
+			}
+			_, err := (&expression{}).scale(operand{Quantity: q}, -1, 3)
+			var e *ExpressionError
+			if !errors.As(err, &e) || e.Pos != 3 || !errors.Is(err, ErrDimensionMismatch) {
+				t.Error(q, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,351 @@
+package measurement
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Match is measurement found in text.
+// Start and End are byte offsets of match in text, including multipack count.
+type Match struct {
+	Start    int         `json:"start"`
+	End      int         `json:"end"`
+	Type     MeasureType `json:"type"`
+	Quantity float32     `json:"quantity,omitzero"`
+	Mass     *Mass       `json:"mass,omitzero"`
+	Volume   *Volume     `json:"volume,omitzero"`
+}
+
+// Conflict is dimension declared more than once, e.g. "400 mL / 13.5 fl oz".
+// Multipack declaration wins, then metric one, then the first one in text.
+type Conflict struct {
+	Winner Match `json:"winner"`
+	Loser  Match `json:"loser"`
+
+	// Consistent is true when both declare same total within 5%.
+	Consistent bool `json:"consistent"`
+}
+
+// Extraction is all measurements found in text and measurements of single item made from them.
+type Extraction struct {
+	Matches      []Match      `json:"matches"`
+	Measurements Measurements `json:"measurements"`
+	Conflicts    []Conflict   `json:"conflicts,omitzero"`
+}
+
+// ExtractorParser is parser used by Extract, it knows English aliases and ignores case.
+var ExtractorParser = Parser{Languages: []Language{LanguageEnglish}, Lenient: true, Grouping: true}
+
+// Extract finds masses and volumes in product titles and free text, e.g. "Coca-Cola Zero 6 x 330ml cans".
+func Extract(s string) Extraction { return ExtractorParser.Extract(s) }
+
+// Extract finds masses and volumes in text using unit spellings of parser.
+// Multipacks "6 x 330ml", "6x330ml" and "330ml x 6" set Quantity of match.
+func (p Parser) Extract(s string) Extraction {
+	symbols := p.scanSymbols()
+
+	var matches []Match
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !isNumberStart(s, i) || isGlued(s, i) {
+			i += size
+			continue
+		}
+		if m, ok := p.scanMultipack(s, i, symbols); ok {
+			matches = append(matches, m)
+			i = m.End
+			continue
+		}
+		// number without unit is skipped whole, so "1234" is not read again from "234"
+		i = max(scanNumber(s, i), i+utf8.RuneLen(r))
+	}
+
+	e := Extraction{Matches: matches}
+	e.Measurements, e.Conflicts = resolveMatches(matches)
+	return e
+}
+
+type scanSymbol struct {
+	symbol string
+	typ    MeasureType
+}
+
+// scanSymbols are all spellings of units, longer first so that "floz" is tried before "fl".
+func (p Parser) scanSymbols() []scanSymbol {
+	var symbols []scanSymbol
+	for _, q := range p.massSymbols() {
+		symbols = append(symbols, scanSymbol{symbol: q.symbol, typ: MeasureTypeMass})
+		symbols = append(symbols, scanSymbol{symbol: q.unit.Symbol(SymbolStyleUnicode), typ: MeasureTypeMass})
+	}
+	for _, q := range p.volumeSymbols() {
+		symbols = append(symbols, scanSymbol{symbol: q.symbol, typ: MeasureTypeVolume})
+		symbols = append(symbols, scanSymbol{symbol: q.unit.Symbol(SymbolStyleUnicode), typ: MeasureTypeVolume})
+	}
+	for symbol, text := range compatibilitySymbols {
+		if _, err := p.ParseMass("1" + text); err == nil {
+			symbols = append(symbols, scanSymbol{symbol: symbol, typ: MeasureTypeMass})
+		} else if _, err := p.ParseVolume("1" + text); err == nil {
+			symbols = append(symbols, scanSymbol{symbol: symbol, typ: MeasureTypeVolume})
+		}
+	}
+	slices.SortFunc(symbols, func(a, b scanSymbol) int {
+		if len(a.symbol) != len(b.symbol) {
+			return len(b.symbol) - len(a.symbol)
+		}
+		return strings.Compare(a.symbol, b.symbol)
+	})
+	return slices.Compact(symbols)
+}
+
+func isWordRune(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' }
+
+// continuesWord is true when i is inside word, as "T" in "T-shirt", but not in "2kg-500g".
+func continuesWord(s string, i int) bool {
+	r, size := utf8.DecodeRuneInString(s[i:])
+	if r == '-' {
+		r, _ = utf8.DecodeRuneInString(s[i+size:])
+		return unicode.IsLetter(r)
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isGlued is true for number that continues word, as in "A4" or "X200", it is not amount.
+// Number after hyphen is not glued to digit-led token, so "500g" in "2kg-500g" is amount.
+func isGlued(s string, i int) bool {
+	prev, size := utf8.DecodeLastRuneInString(s[:i])
+	if prev == '-' {
+		j := i - size
+		for j > 0 {
+			r, size := utf8.DecodeLastRuneInString(s[:j])
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+				break
+			}
+			j -= size
+		}
+		if r, _ := utf8.DecodeRuneInString(s[j:]); j < i-size && unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return i > 0 && (isWordRune(prev) || prev == '.' || prev == ',')
+}
+
+func isNumberStart(s string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return unicode.IsDigit(r) || (isFractionRune(r) && r != '/' && r != '⁄')
+}
+
+// scanNumber is end of number starting at i: digits with inner separators, fraction or mixed number.
+func scanNumber(s string, i int) int {
+	end := i
+	for j := i; j < len(s); {
+		r, size := utf8.DecodeRuneInString(s[j:])
+		switch {
+		case unicode.IsDigit(r):
+			end = j + size
+		case isFractionRune(r) && r != '/' && r != '⁄':
+			return j + size
+		case r == '.' || r == ',' || r == '/' || r == '⁄':
+			// separator counts only between digits
+			if next, _ := utf8.DecodeRuneInString(s[j+size:]); !unicode.IsDigit(next) || end != j {
+				return end
+			}
+		case r == ' ':
+			// mixed number "1 1/2" or "1 ½"
+			k := j + size
+			n := scanNumber(s, k)
+			if n == k || (!strings.ContainsAny(s[k:n], "/⁄") && !strings.ContainsFunc(s[k:n], isFractionRune)) {
+				return end
+			}
+			return n
+		default:
+			return end
+		}
+		j += size
+	}
+	return end
+}
+
+func skipSpaces(s string, i int) int {
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// scanTimes is end of multiplication sign and spaces around it at i, or -1.
+func scanTimes(s string, i int) int {
+	i = skipSpaces(s, i)
+	r, size := utf8.DecodeRuneInString(s[i:])
+	if r != 'x' && r != 'X' && r != '×' && r != '*' {
+		return -1
+	}
+	return skipSpaces(s, i+size)
+}
+
+func parseCount(s string) (float32, bool) {
+	v, err := strconv.ParseUint(s, 10, 32)
+	return float32(v), err == nil && v > 0
+}
+
+// scanMultipack reads "N x Q", "Q x N" or just "Q" at i.
+func (p Parser) scanMultipack(s string, i int, symbols []scanSymbol) (Match, bool) {
+	n := scanNumber(s, i)
+
+	if k := scanTimes(s, n); k != -1 && k < len(s) && isNumberStart(s, k) {
+		if count, ok := parseCount(s[i:n]); ok {
+			if m, ok := p.scanMeasure(s, k, symbols); ok {
+				m.Start, m.Quantity = i, count
+				return m, true
+			}
+		}
+	}
+
+	m, ok := p.scanMeasure(s, i, symbols)
+	if !ok {
+		return Match{}, false
+	}
+
+	if k := scanTimes(s, m.End); k != -1 && k < len(s) && isNumberStart(s, k) {
+		n := scanNumber(s, k)
+		next, _ := utf8.DecodeRuneInString(s[n:])
+		if count, ok := parseCount(s[k:n]); ok && !isWordRune(next) {
+			m.End, m.Quantity = n, count
+		}
+	}
+
+	return m, true
+}
+
+// scanMeasure reads amount and unit at i.
+func (p Parser) scanMeasure(s string, i int, symbols []scanSymbol) (Match, bool) {
+	n := scanNumber(s, i)
+	if n == i {
+		return Match{}, false
+	}
+	j := skipSpaces(s, n)
+
+	for _, q := range symbols {
+		k, ok := matchSymbol(s[j:], q.symbol, p.Lenient)
+		if !ok {
+			continue
+		}
+		end := j + k
+		if continuesWord(s, end) {
+			continue
+		}
+
+		m := Match{Start: i, End: end, Type: q.typ}
+		switch q.typ {
+		case MeasureTypeMass:
+			v, err := p.ParseMass(s[i:end])
+			if err != nil {
+				continue
+			}
+			m.Mass = v
+		case MeasureTypeVolume:
+			v, err := p.ParseVolume(s[i:end])
+			if err != nil {
+				continue
+			}
+			m.Volume = v
+		}
+		return m, true
+	}
+
+	return Match{}, false
+}
+
+// matchSymbol is length of symbol at start of s.
+// Lenient match ignores case, and spaces and dots inside symbol, as Parser does.
+func matchSymbol(s, symbol string, lenient bool) (int, bool) {
+	if !lenient {
+		return len(symbol), symbol != "" && strings.HasPrefix(s, symbol)
+	}
+
+	want := []rune(foldSymbol(symbol))
+	if len(want) == 0 {
+		return 0, false
+	}
+
+	i := 0
+	for len(want) > 0 && i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case unicode.ToLower(r) == want[0]:
+			want = want[1:]
+		case i == 0 || !(r == ' ' || r == '.'):
+			return 0, false
+		}
+		i += size
+	}
+	return i, len(want) == 0
+}
+
+func (s Match) isMetric() bool {
+	if s.Mass != nil {
+		return unitMassLadder.indexOf(s.Mass.Unit) != -1
+	}
+	if s.Volume != nil {
+		return unitVolumeLiterLadder.indexOf(s.Volume.Unit) != -1 || unitVolumeMeterLadder.indexOf(s.Volume.Unit) != -1
+	}
+	return false
+}
+
+func (s Match) wins(o Match) bool {
+	if (s.Quantity > 0) != (o.Quantity > 0) {
+		return s.Quantity > 0
+	}
+	return s.isMetric() && !o.isMetric()
+}
+
+// total is amount of all items in pack in given unit.
+func (s Match) total(unit Match) float64 {
+	q := float64(max(s.Quantity, 1))
+	if s.Mass != nil {
+		return q * s.Mass.Convert(unit.Mass.Unit).Amount
+	}
+	return q * s.Volume.Convert(unit.Volume.Unit).Amount
+}
+
+func resolveMatches(matches []Match) (Measurements, []Conflict) {
+	var m Measurements
+	var conflicts []Conflict
+	winners := make(map[MeasureType]Match)
+
+	for _, q := range matches {
+		w, ok := winners[q.Type]
+		if !ok {
+			winners[q.Type] = q
+			continue
+		}
+
+		c := Conflict{Winner: w, Loser: q}
+		if q.wins(w) {
+			c = Conflict{Winner: q, Loser: w}
+			winners[q.Type] = q
+		}
+		a, b := c.Winner.total(c.Winner), c.Loser.total(c.Winner)
+		c.Consistent = math.Abs(a-b) <= 0.05*math.Abs(a)
+		conflicts = append(conflicts, c)
+	}
+
+	for _, w := range winners {
+		m.Quantity = max(m.Quantity, w.Quantity, 1)
+		if w.Mass != nil {
+			m.Mass = w.Mass
+		}
+		if w.Volume != nil {
+			m.Volume = w.Volume
+		}
+	}
+
+	return m, conflicts
+}
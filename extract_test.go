@@ -0,0 +1,126 @@
+package measurement
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleExtract() {
+	s := "Coca-Cola Zero 6 x 330ml cans"
+	e := Extract(s)
+	m := e.Matches[0]
+	fmt.Println(s[m.Start:m.End], e.Measurements.Quantity, e.Measurements.Volume)
+	// Output: 6 x 330ml 6 330ml
+}
+
+func TestExtract(t *testing.T) {
+	type match struct {
+		text     string
+		quantity float32
+		mass     *Mass
+		volume   *Volume
+	}
+
+	tests := map[string][]match{
+		"Coca-Cola Zero 6 x 330ml cans": {{"6 x 330ml", 6, nil, &Volume{330, UnitMilliLiters}}},
+		"Coca-Cola Zero 6x330ml":        {{"6x330ml", 6, nil, &Volume{330, UnitMilliLiters}}},
+		"Water 1.5L × 6":                {{"1.5L × 6", 6, nil, &Volume{1.5, UnitLiters}}},
+		"Rice Basmati 2kg bag":          {{"2kg", 0, &Mass{2, UnitKilograms}, nil}},
+		"Flour 1,000 g":                 {{"1,000 g", 0, &Mass{1000, UnitGrams}, nil}},
+		"Milk 1 ½ l":                    {{"1 ½ l", 0, nil, &Volume{1.5, UnitLiters}}},
+		"Oil 13.5 FL. OZ.":              {{"13.5 FL. OZ", 0, nil, &Volume{13.5, UnitFluidOunces}}},
+		"Dumbbell 5 lbs":                {{"5 lbs", 0, &Mass{5, UnitPounds}, nil}},
+		"Cream 250 ㎖":                   {{"250 ㎖", 0, nil, &Volume{250, UnitMilliLiters}}},
+		"Shampoo 400 mL / 13.5 fl oz": {
+			{"400 mL", 0, nil, &Volume{400, UnitMilliLiters}},
+			{"13.5 fl oz", 0, nil, &Volume{13.5, UnitFluidOunces}},
+		},
+		"Tea 20 bags 40g, 2 x 250ml mugs": {
+			{"40g", 0, &Mass{40, UnitGrams}, nil},
+			{"2 x 250ml", 2, nil, &Volume{250, UnitMilliLiters}},
+		},
+		"Rice 2kg-500g bag": {
+			{"2kg", 0, &Mass{2, UnitKilograms}, nil},
+			{"500g", 0, &Mass{500, UnitGrams}, nil},
+		},
+		"Model X-200 500ml": {{"500ml", 0, nil, &Volume{500, UnitMilliLiters}}},
+		"iPhone 15 Pro":     nil,
+		"Size 2 T-shirt":    nil,
+		"5 grapes":          nil,
+		"A4 paper, 80g":     {{"80g", 0, &Mass{80, UnitGrams}, nil}},
+		"Model X200 500ml":  {{"500ml", 0, nil, &Volume{500, UnitMilliLiters}}},
+		"Code 1234567 1 kg": {{"1 kg", 0, &Mass{1, UnitKilograms}, nil}},
+	}
+	for s, want := range tests {
+		t.Run(s, func(t *testing.T) {
+			got := Extract(s).Matches
+			if len(got) != len(want) {
+				t.Fatal(got)
+			}
+			for i, m := range got {
+				w := want[i]
+				if s[m.Start:m.End] != w.text || m.Quantity != w.quantity {
+					t.Error(s[m.Start:m.End], m.Quantity)
+				}
+				if (m.Mass == nil) != (w.mass == nil) || (m.Mass != nil && *m.Mass != *w.mass) {
+					t.Error(m.Mass, w.mass)
+				}
+				if (m.Volume == nil) != (w.volume == nil) || (m.Volume != nil && *m.Volume != *w.volume) {
+					t.Error(m.Volume, w.volume)
+				}
+			}
+		})
+	}
+
+	t.Run("measurements", func(t *testing.T) {
+		e := Extract("Rice Basmati 2kg bag")
+		if e.Measurements.Quantity != 1 || *e.Measurements.Mass != (Mass{2, UnitKilograms}) || e.Measurements.Volume != nil {
+			t.Error(e.Measurements)
+		}
+
+		if e := Extract("no measurements here"); !e.Measurements.IsZero() || len(e.Conflicts) != 0 {
+			t.Error(e)
+		}
+	})
+
+	t.Run("when dual declaration, then metric wins", func(t *testing.T) {
+		e := Extract("Shampoo 13.5 fl oz (400 mL)")
+		if len(e.Conflicts) != 1 {
+			t.Fatal(e.Conflicts)
+		}
+		c := e.Conflicts[0]
+		if *c.Winner.Volume != (Volume{400, UnitMilliLiters}) || *c.Loser.Volume != (Volume{13.5, UnitFluidOunces}) || !c.Consistent {
+			t.Error(c)
+		}
+		if *e.Measurements.Volume != (Volume{400, UnitMilliLiters}) {
+			t.Error(e.Measurements)
+		}
+	})
+
+	t.Run("when dual declaration of same system, then first wins", func(t *testing.T) {
+		e := Extract("Juice 1l 900ml")
+		if len(e.Conflicts) != 1 || *e.Conflicts[0].Winner.Volume != (Volume{1, UnitLiters}) || e.Conflicts[0].Consistent {
+			t.Error(e.Conflicts)
+		}
+	})
+
+	t.Run("when multipack and total, then multipack wins", func(t *testing.T) {
+		e := Extract("Cola 1.98L 6 x 330ml")
+		if len(e.Conflicts) != 1 || e.Conflicts[0].Winner.Quantity != 6 || !e.Conflicts[0].Consistent {
+			t.Error(e.Conflicts)
+		}
+		if e.Measurements.Quantity != 6 || *e.Measurements.Volume != (Volume{330, UnitMilliLiters}) {
+			t.Error(e.Measurements)
+		}
+	})
+
+	t.Run("when strict parser, then case matters", func(t *testing.T) {
+		p := Parser{}
+		if e := p.Extract("2 KG"); len(e.Matches) != 0 {
+			t.Error(e.Matches)
+		}
+		if e := p.Extract("2 kg"); len(e.Matches) != 1 {
+			t.Error(e.Matches)
+		}
+	})
+}
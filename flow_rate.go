@@ -0,0 +1,184 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidFlowRateAmount = errors.New("invalid flow rate amount")
+	ErrInvalidFlowRateUnit   = errors.New("invalid flow rate unit")
+)
+
+// FlowRate is volume per time, e.g. pump "12l/min" or fan "150ft3/min".
+type FlowRate struct {
+	Amount float64      `json:"amount"`
+	Unit   UnitFlowRate `json:"unit"`
+}
+
+// shortSymbolsFlowRate are industry symbols accepted in any language besides canonical ones.
+var shortSymbolsFlowRate = map[string]UnitFlowRate{
+	"gpm": UnitGallonsPerMinute,
+	"cfm": UnitCubicFeetPerMinute,
+}
+
+// NewFlowRateFromString parses canonical symbols and short ones, e.g. "12l/min", "5gpm" and "150cfm".
+func NewFlowRateFromString(s string) (*FlowRate, error) {
+	s = normalizeUnicode(s)
+
+	var unit UnitFlowRate
+	var symbol string
+	for _, u := range UnitFlowRateAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > len(symbol) {
+			unit, symbol = u, u.String()
+		}
+	}
+	for q, u := range shortSymbolsFlowRate {
+		if strings.HasSuffix(s, q) && len(q) > len(symbol) {
+			unit, symbol = u, q
+		}
+	}
+
+	if unit == UnitFlowRateUnknown {
+		return nil, ErrInvalidFlowRateUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(symbol)])
+	if a == "" {
+		return nil, ErrInvalidFlowRateAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FlowRate{Amount: amount, Unit: unit}, nil
+}
+
+func (s FlowRate) String() string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String()
+}
+
+func (s FlowRate) StringStyle(style SymbolStyle) string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.Symbol(style)
+}
+
+func (s *FlowRate) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+// Convert converts volume as Volume.Convert does, time units are exact.
+func (s FlowRate) Convert(unit UnitFlowRate) FlowRate {
+	if v, ok := TryConvertExactFlowRate(s.Amount, s.Unit, unit); ok {
+		return FlowRate{Amount: v, Unit: unit}
+	}
+	a, b := unitFlowRateRatios[s.Unit], unitFlowRateRatios[unit]
+	v := Volume{Amount: s.Amount, Unit: a.volume}.Convert(b.volume).Amount
+	v *= Time{Amount: 1, Unit: b.time}.Convert(a.time).Amount
+	return FlowRate{Amount: v, Unit: unit}
+}
+
+// Add sums flow rates in unit of s.
+func (s FlowRate) Add(o FlowRate) FlowRate {
+	return FlowRate{Amount: s.Amount + o.Convert(s.Unit).Amount, Unit: s.Unit}
+}
+
+// Sub subtracts flow rates in unit of s.
+func (s FlowRate) Sub(o FlowRate) FlowRate { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number, e.g. count of pumps.
+func (s FlowRate) Scale(k float64) FlowRate { return FlowRate{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 1l/s / 15l/min is 4.
+func (s FlowRate) Div(o FlowRate) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
+// Volume is volume that flows in time, e.g. 12l/min for 5min is 60l.
+func (s FlowRate) Volume(t Time) Volume {
+	r := unitFlowRateRatios[s.Unit]
+	return Volume{Amount: s.Amount * t.Convert(r.time).Amount, Unit: r.volume}
+}
+
+// TryConvertExactFlowRate converts volume with TryConvertExactVolume and time along time ladder, e.g. 1l/s is exactly 3600l/h.
+func TryConvertExactFlowRate[T int32 | int64 | float32 | float64](amount T, from, to UnitFlowRate) (v T, ok bool) {
+	a, ok := unitFlowRateRatios[from]
+	if !ok {
+		return 0, false
+	}
+	b, ok := unitFlowRateRatios[to]
+	if !ok {
+		return 0, false
+	}
+
+	v, ok = TryConvertExactVolume(amount, a.volume, b.volume)
+	if !ok {
+		return 0, false
+	}
+	// volume in one `a` time unit is volume in as many `b` time units as are in one `a` time unit
+	return TryConvertExactTime(v, b.time, a.time)
+}
+
+type UnitFlowRate uint8
+
+//go:generate go-enum-encoding -type=UnitFlowRate -string
+const (
+	UnitFlowRateUnknown          UnitFlowRate = iota // json:""
+	UnitMilliLitersPerMinute                         // json:"ml/min"
+	UnitLitersPerSecond                              // json:"l/s"
+	UnitLitersPerMinute                              // json:"l/min"
+	UnitLitersPerHour                                // json:"l/h"
+	UnitCubicMetersPerSecond                         // json:"m3/s"
+	UnitCubicMetersPerHour                           // json:"m3/h"
+	UnitGallonsPerMinute                             // json:"gal/min"
+	UnitImperialGallonsPerMinute                     // json:"impgal/min"
+	UnitCubicFeetPerMinute                           // json:"ft3/min"
+)
+
+func (s UnitFlowRate) Symbol(style SymbolStyle) string {
+	if v, ok := unicodeSymbolsFlowRate[s]; ok && style == SymbolStyleUnicode {
+		return v
+	}
+	return s.String()
+}
+
+// Ratio is volume and time units that define flow rate unit, gpm is US gallon per minute.
+func (s UnitFlowRate) Ratio() (UnitVolume, UnitTime) {
+	r := unitFlowRateRatios[s]
+	return r.volume, r.time
+}
+
+var UnitFlowRateAll = [...]UnitFlowRate{
+	UnitMilliLitersPerMinute,
+	UnitLitersPerSecond,
+	UnitLitersPerMinute,
+	UnitLitersPerHour,
+	UnitCubicMetersPerSecond,
+	UnitCubicMetersPerHour,
+	UnitGallonsPerMinute,
+	UnitImperialGallonsPerMinute,
+	UnitCubicFeetPerMinute,
+}
+
+type flowRateRatio struct {
+	volume UnitVolume
+	time   UnitTime
+}
+
+var unitFlowRateRatios = map[UnitFlowRate]flowRateRatio{
+	UnitMilliLitersPerMinute:     {UnitMilliLiters, UnitMinutes},
+	UnitLitersPerSecond:          {UnitLiters, UnitSeconds},
+	UnitLitersPerMinute:          {UnitLiters, UnitMinutes},
+	UnitLitersPerHour:            {UnitLiters, UnitHours},
+	UnitCubicMetersPerSecond:     {UnitCubicMeters, UnitSeconds},
+	UnitCubicMetersPerHour:       {UnitCubicMeters, UnitHours},
+	UnitGallonsPerMinute:         {UnitGallons, UnitMinutes},
+	UnitImperialGallonsPerMinute: {UnitImperialGallons, UnitMinutes},
+	UnitCubicFeetPerMinute:       {UnitCubicFeet, UnitMinutes},
+}
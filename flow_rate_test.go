@@ -0,0 +1,162 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func ExampleNewFlowRateFromString() {
+	v, _ := NewFlowRateFromString("12l/min")
+	fmt.Println(v.Amount, v.Unit, v.Convert(UnitLitersPerHour), v.Volume(Time{Amount: 5, Unit: UnitMinutes}))
+	// Output: 12 l/min 720l/h 60l
+}
+
+func TestFlowRate(t *testing.T) {
+	tests := map[string]FlowRate{
+		"12l/min":     {Amount: 12, Unit: UnitLitersPerMinute},
+		"5gal/min":    {Amount: 5, Unit: UnitGallonsPerMinute},
+		"5impgal/min": {Amount: 5, Unit: UnitImperialGallonsPerMinute},
+		"150ft3/min":  {Amount: 150, Unit: UnitCubicFeetPerMinute},
+		"2.5m3/h":     {Amount: 2.5, Unit: UnitCubicMetersPerHour},
+		"250ml/min":   {Amount: 250, Unit: UnitMilliLitersPerMinute},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewFlowRateFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("unicode", func(t *testing.T) {
+		v := FlowRate{Amount: 2.5, Unit: UnitCubicMetersPerHour}
+		if s := v.StringStyle(SymbolStyleUnicode); s != "2.5m³/h" {
+			t.Error(s)
+		}
+		if u, err := NewFlowRateFromString("2.5m³/h"); err != nil || *u != v {
+			t.Error(u, err)
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewFlowRateFromString("5"); !errors.Is(err, ErrInvalidFlowRateUnit) {
+			t.Error(err)
+		}
+		if _, err := NewFlowRateFromString("l/min"); !errors.Is(err, ErrInvalidFlowRateAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(FlowRate{Amount: 2, Unit: UnitLitersPerMinute})
+		if err != nil || string(b) != `{"amount":2,"unit":"l/min"}` {
+			t.Error(string(b), err)
+		}
+		var v FlowRate
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"gal/min"}`), &v); err != nil || v != (FlowRate{3, UnitGallonsPerMinute}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestFlowRateConversion(t *testing.T) {
+	tests := [][2]FlowRate{
+		{{1, UnitLitersPerSecond}, {3600, UnitLitersPerHour}},
+		{{1, UnitLitersPerMinute}, {1000, UnitMilliLitersPerMinute}},
+		{{1, UnitCubicMetersPerSecond}, {3600, UnitCubicMetersPerHour}},
+		{{6, UnitCubicMetersPerHour}, {100, UnitLitersPerMinute}},
+		{{5, UnitGallonsPerMinute}, {18.927, UnitLitersPerMinute}},
+		{{5, UnitImperialGallonsPerMinute}, {22.730, UnitLitersPerMinute}},
+		{{1, UnitCubicFeetPerMinute}, {1.699, UnitCubicMetersPerHour}},
+		{{14.7, UnitLitersPerHour}, {0.064725, UnitGallonsPerMinute}},
+		{{0.7, UnitLitersPerSecond}, {11.0953, UnitGallonsPerMinute}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-3*b.Amount || c.Unit != b.Unit {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-3*a.Amount || c.Unit != a.Unit {
+			t.Error(c, a)
+		}
+	}
+
+	t.Run("when any amount, then not zero", func(t *testing.T) {
+		for i := 1; i <= 1000; i++ {
+			v := FlowRate{Amount: float64(i) / 10, Unit: UnitLitersPerHour}
+			if c := v.Convert(UnitGallonsPerMinute); math.Abs(c.Amount-v.Amount/3.785411784/60) > 1e-6*c.Amount {
+				t.Fatal(v, c)
+			}
+		}
+	})
+
+	t.Run("when us and imperial gallons, then different", func(t *testing.T) {
+		us := FlowRate{Amount: 1, Unit: UnitGallonsPerMinute}.Convert(UnitLitersPerMinute)
+		imp := FlowRate{Amount: 1, Unit: UnitImperialGallonsPerMinute}.Convert(UnitLitersPerMinute)
+		if math.Abs(imp.Amount/us.Amount-1.2) > 0.01 {
+			t.Error(us, imp)
+		}
+	})
+}
+
+func TestTryConvertExactFlowRate(t *testing.T) {
+	if v, ok := TryConvertExactFlowRate[int64](2, UnitLitersPerSecond, UnitLitersPerHour); !ok || v != 7200 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactFlowRate[int64](120, UnitLitersPerHour, UnitLitersPerMinute); !ok || v != 2 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactFlowRate[int64](100, UnitLitersPerHour, UnitLitersPerMinute); ok {
+		t.Error("5/3 l/min is not whole", v)
+	}
+	if v, ok := TryConvertExactFlowRate[int64](1, UnitGallonsPerMinute, UnitLitersPerMinute); ok {
+		t.Error("gallon is approximate", v)
+	}
+}
+
+func TestFlowRate_Add(t *testing.T) {
+	if v := (FlowRate{1, UnitLitersPerSecond}).Add(FlowRate{60, UnitLitersPerMinute}); v != (FlowRate{2, UnitLitersPerSecond}) {
+		t.Error(v)
+	}
+}
+
+func TestParser_ParseFlowRate(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]FlowRate{
+		"12 l/min":  {12, UnitLitersPerMinute},
+		"12 L/min":  {12, UnitLitersPerMinute},
+		"5 gpm":     {5, UnitGallonsPerMinute},
+		"5 GPM":     {5, UnitGallonsPerMinute},
+		"5 imp gpm": {5, UnitImperialGallonsPerMinute},
+		"150 cfm":   {150, UnitCubicFeetPerMinute},
+		"2,5 м³/ч":  {2.5, UnitCubicMetersPerHour},
+		"30 lpm":    {30, UnitLitersPerMinute},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseFlowRate(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+
+	t.Run("when zero parser, then short symbols", func(t *testing.T) {
+		for s, v := range map[string]FlowRate{"5 gpm": {5, UnitGallonsPerMinute}, "150cfm": {150, UnitCubicFeetPerMinute}} {
+			if u, err := (Parser{}).ParseFlowRate(s); err != nil || *u != v {
+				t.Error(s, u, err)
+			}
+			if u, err := NewFlowRateFromString(strings.ReplaceAll(s, " ", "")); err != nil || *u != v {
+				t.Error(s, u, err)
+			}
+		}
+	})
+}
@@ -1,5 +1,7 @@
 package measurement
 
+import "math"
+
 type ladderItem[U comparable] struct {
 	unit     U
 	fromPrev int
@@ -18,6 +20,53 @@ func (l ladder[U]) indexOf(unit U) int {
 	return -1
 }
 
+// span is product of multipliers between two positions in ladder.
+func (l ladder[U]) span(i, j int) (int, bool) {
+	if i > j {
+		i, j = j, i
+	}
+
+	f := 1
+	for idx := i + 1; idx <= j; idx++ {
+		prev := f
+		f *= l[idx].fromPrev
+		if f/l[idx].fromPrev != prev {
+			return 0, false // factor overflow
+		}
+	}
+	return f, true
+}
+
+// factor is how many `to` units are in one `from` unit.
+func (l ladder[U]) factor(from, to U) (Rational, bool) {
+	idxFrom, idxTo := l.indexOf(from), l.indexOf(to)
+	if idxFrom == -1 || idxTo == -1 {
+		return Rational{}, false
+	}
+
+	f, ok := l.span(idxFrom, idxTo)
+	if !ok {
+		return Rational{}, false
+	}
+
+	if idxFrom < idxTo {
+		return Rational{Num: 1, Den: int64(f)}, true
+	}
+	return Rational{Num: int64(f), Den: 1}, true
+}
+
+// finer is unit of the two that is lower in ladder, conversion to it multiplies by whole factor.
+func (l ladder[U]) finer(a, b U) (U, bool) {
+	i, j := l.indexOf(a), l.indexOf(b)
+	if i == -1 || j == -1 {
+		return a, false
+	}
+	if j < i {
+		return b, true
+	}
+	return a, true
+}
+
 func convertByLadder[U comparable, T int32 | int64 | float32 | float64](amount T, from, to U, ladder ladder[U]) (T, bool) {
 	if from == to || amount == 0 {
 		return amount, true
@@ -28,22 +77,9 @@ func convertByLadder[U comparable, T int32 | int64 | float32 | float64](amount T
 		return amount, false
 	}
 
-	var f int = 1
-
-	for idx := idxFrom; (idx + 1) <= idxTo; idx++ {
-		prev := f
-		f *= ladder[idx+1].fromPrev
-		if f/ladder[idx+1].fromPrev != prev {
-			return 0, false // factor overflow
-		}
-	}
-
-	for idx := idxFrom; idx > idxTo; idx-- {
-		prev := f
-		f *= ladder[idx].fromPrev
-		if f/ladder[idx].fromPrev != prev {
-			return 0, false // factor overflow
-		}
+	f, ok := ladder.span(idxFrom, idxTo)
+	if !ok {
+		return 0, false // factor overflow
 	}
 
 	ft := T(f)
@@ -63,3 +99,89 @@ func convertByLadder[U comparable, T int32 | int64 | float32 | float64](amount T
 
 	return amount, true
 }
+
+// convertByLadderApprox multiplies by factor between units without exactness checks, as approximate conversions need.
+func convertByLadderApprox[U comparable, T float32 | float64](amount T, from, to U, ladder ladder[U]) (T, bool) {
+	f, ok := ladder.factor(from, to)
+	if !ok {
+		return 0, false
+	}
+	return amount * T(f.Num) / T(f.Den), true
+}
+
+// bridge joins two ladders with exact factor, it is how many `to` units are in one `from` unit.
+type bridge[U comparable] struct {
+	from   U
+	to     U
+	factor Rational
+}
+
+// exactFactor is how many `to` units are in one `from` unit, going along ladders and across bridges.
+// Path is found breadth first, so it has fewest steps, e.g. nautical mile to inch goes through meter and millimeter.
+func exactFactor[U comparable](from, to U, ladders []ladder[U], bridges []bridge[U]) (Rational, bool) {
+	factors := map[U]Rational{from: {Num: 1, Den: 1}}
+	queue := []U{from}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+
+		f := factors[u]
+		if u == to {
+			return f, true
+		}
+
+		visit := func(v U, g Rational) {
+			if _, ok := factors[v]; ok {
+				return
+			}
+			if h, ok := f.mul(g); ok {
+				factors[v] = h
+				queue = append(queue, v)
+			}
+		}
+		for _, l := range ladders {
+			if l.indexOf(u) == -1 {
+				continue
+			}
+			for _, item := range l {
+				if g, ok := l.factor(u, item.unit); ok {
+					visit(item.unit, g)
+				}
+			}
+		}
+		for _, b := range bridges {
+			if b.from == u {
+				visit(b.to, b.factor)
+			}
+			if b.to == u {
+				visit(b.from, NewRational(b.factor.Den, b.factor.Num))
+			}
+		}
+	}
+	return Rational{}, false
+}
+
+// convertByRational multiplies by exact factor, failing when result is not whole for integers or overflows.
+func convertByRational[T int32 | int64 | float32 | float64](amount T, f Rational) (T, bool) {
+	num, den := T(f.Num), T(f.Den)
+	if int64(num) != f.Num || int64(den) != f.Den || den == 0 {
+		return 0, false // factor overflow
+	}
+
+	if integer := T(1)/T(2) == 0; !integer {
+		v := amount * num / den
+		if math.IsInf(float64(v), 0) {
+			return 0, false // overflow
+		}
+		return v, true
+	}
+
+	v := amount * num
+	if num != 0 && v/num != amount {
+		return 0, false // overflow
+	}
+	if (v/den)*den != v {
+		return 0, false // loss of precision without fractions
+	}
+	return v / den, true
+}
@@ -0,0 +1,1253 @@
+package measurement
+
+// Language is localized spelling of units and numbers.
+// Unit names are matched as is, so list all common forms (abbreviations, singular, plural).
+type Language struct {
+	Decimal rune
+	Group   rune
+	Mass    map[string]UnitMass
+	Volume  map[string]UnitVolume
+	Length  map[string]UnitLength
+	Area    map[string]UnitArea
+
+	// Temperature names are matched after degree sign is removed, "°C" is "C".
+	Temperature map[string]UnitTemperature
+
+	Time          map[string]UnitTime
+	Energy        map[string]UnitEnergy
+	Power         map[string]UnitPower
+	Pressure      map[string]UnitPressure
+	Speed         map[string]UnitSpeed
+	Density       map[string]UnitDensity
+	FlowRate      map[string]UnitFlowRate
+	Concentration map[string]UnitConcentration
+}
+
+func (s Language) numberFormat() numberFormat {
+	return numberFormat{decimal: s.Decimal, group: s.Group}
+}
+
+var LanguageAll = [...]Language{
+	LanguageEnglish,
+	LanguageRussian,
+	LanguageChinese,
+	LanguageJapanese,
+	LanguageSpanish,
+}
+
+// LanguageEnglish is common English names and abbreviations of units.
+var LanguageEnglish = Language{
+	Decimal: '.',
+	Group:   ',',
+	Mass: map[string]UnitMass{
+		"picogram":    UnitPicograms,
+		"picograms":   UnitPicograms,
+		"nanogram":    UnitNanograms,
+		"nanograms":   UnitNanograms,
+		"ug":          UnitMicrograms,
+		"microgram":   UnitMicrograms,
+		"micrograms":  UnitMicrograms,
+		"milligram":   UnitMilligrams,
+		"milligrams":  UnitMilligrams,
+		"centigram":   UnitCentigrams,
+		"centigrams":  UnitCentigrams,
+		"decigram":    UnitDecigrams,
+		"decigrams":   UnitDecigrams,
+		"gr":          UnitGrams,
+		"gram":        UnitGrams,
+		"grams":       UnitGrams,
+		"kgs":         UnitKilograms,
+		"kilo":        UnitKilograms,
+		"kilos":       UnitKilograms,
+		"kilogram":    UnitKilograms,
+		"kilograms":   UnitKilograms,
+		"ounce":       UnitOunces,
+		"ounces":      UnitOunces,
+		"#":           UnitPounds,
+		"lbs":         UnitPounds,
+		"pound":       UnitPounds,
+		"pounds":      UnitPounds,
+		"stone":       UnitStones,
+		"stones":      UnitStones,
+		"t":           UnitMetricTons,
+		"tonne":       UnitMetricTons,
+		"tonnes":      UnitMetricTons,
+		"metric tons": UnitMetricTons,
+		"short ton":   UnitShortTons,
+		"short tons":  UnitShortTons,
+		"carat":       UnitCarats,
+		"carats":      UnitCarats,
+		"troy oz":     UnitOuncesTroy,
+		"troy ounce":  UnitOuncesTroy,
+		"troy ounces": UnitOuncesTroy,
+		"slugs":       UnitSlugs,
+	},
+	Volume: map[string]UnitVolume{
+		"mL":                    UnitMilliLiters,
+		"milliliter":            UnitMilliLiters,
+		"milliliters":           UnitMilliLiters,
+		"millilitre":            UnitMilliLiters,
+		"millilitres":           UnitMilliLiters,
+		"cL":                    UnitCentiLiters,
+		"centiliters":           UnitCentiLiters,
+		"centilitres":           UnitCentiLiters,
+		"dL":                    UnitDeciLiters,
+		"deciliters":            UnitDeciLiters,
+		"decilitres":            UnitDeciLiters,
+		"L":                     UnitLiters,
+		"ltr":                   UnitLiters,
+		"liter":                 UnitLiters,
+		"liters":                UnitLiters,
+		"litre":                 UnitLiters,
+		"litres":                UnitLiters,
+		"kL":                    UnitKiloLiters,
+		"kiloliters":            UnitKiloLiters,
+		"kilolitres":            UnitKiloLiters,
+		"megaliters":            UnitMegaLiters,
+		"megalitres":            UnitMegaLiters,
+		"cubic millimeters":     UnitCubicMilliMeters,
+		"cc":                    UnitCubicCentiMeters,
+		"cubic centimeters":     UnitCubicCentiMeters,
+		"cubic decimeters":      UnitCubicDeciMeters,
+		"cu ft":                 UnitCubicFeet,
+		"cubic feet":            UnitCubicFeet,
+		"cu in":                 UnitCubicInches,
+		"cubic inches":          UnitCubicInches,
+		"cubic meters":          UnitCubicMeters,
+		"cubic metres":          UnitCubicMeters,
+		"cubic kilometers":      UnitCubicKiloMeters,
+		"cubic miles":           UnitCubicMiles,
+		"cu yd":                 UnitCubicYards,
+		"cubic yards":           UnitCubicYards,
+		"bushel":                UnitBushels,
+		"bushels":               UnitBushels,
+		"cups":                  UnitCups,
+		"fl oz":                 UnitFluidOunces,
+		"fluid ounce":           UnitFluidOunces,
+		"fluid ounces":          UnitFluidOunces,
+		"gallon":                UnitGallons,
+		"gallons":               UnitGallons,
+		"pint":                  UnitPints,
+		"pints":                 UnitPints,
+		"quart":                 UnitQuarts,
+		"quarts":                UnitQuarts,
+		"tablespoon":            UnitTablespoons,
+		"tablespoons":           UnitTablespoons,
+		"teaspoon":              UnitTeaspoons,
+		"teaspoons":             UnitTeaspoons,
+		"imperial fl oz":        UnitImperialFluidOunces,
+		"imperial fluid ounces": UnitImperialFluidOunces,
+		"imperial gallon":       UnitImperialGallons,
+		"imperial gallons":      UnitImperialGallons,
+		"gill":                  UnitImperialGills,
+		"gills":                 UnitImperialGills,
+		"imperial pint":         UnitImperialPints,
+		"imperial pints":        UnitImperialPints,
+		"imperial quart":        UnitImperialQuarts,
+		"imperial quarts":       UnitImperialQuarts,
+		"imperial tablespoons":  UnitImperialTablespoons,
+		"imperial teaspoons":    UnitImperialTeaspoons,
+	},
+	Length: map[string]UnitLength{
+		"millimeter":     UnitMilliMeters,
+		"millimeters":    UnitMilliMeters,
+		"millimetre":     UnitMilliMeters,
+		"millimetres":    UnitMilliMeters,
+		"centimeter":     UnitCentiMeters,
+		"centimeters":    UnitCentiMeters,
+		"centimetre":     UnitCentiMeters,
+		"centimetres":    UnitCentiMeters,
+		"decimeter":      UnitDeciMeters,
+		"decimeters":     UnitDeciMeters,
+		"meter":          UnitMeters,
+		"meters":         UnitMeters,
+		"metre":          UnitMeters,
+		"metres":         UnitMeters,
+		"kilometer":      UnitKiloMeters,
+		"kilometers":     UnitKiloMeters,
+		"kilometre":      UnitKiloMeters,
+		"kilometres":     UnitKiloMeters,
+		"\"":             UnitInches,
+		"inch":           UnitInches,
+		"inches":         UnitInches,
+		"'":              UnitFeet,
+		"foot":           UnitFeet,
+		"feet":           UnitFeet,
+		"yard":           UnitYards,
+		"yards":          UnitYards,
+		"mile":           UnitMiles,
+		"miles":          UnitMiles,
+		"nautical mile":  UnitNauticalMiles,
+		"nautical miles": UnitNauticalMiles,
+	},
+	Area: map[string]UnitArea{
+		"sq mm":              UnitSquareMilliMeters,
+		"square millimeters": UnitSquareMilliMeters,
+		"sq cm":              UnitSquareCentiMeters,
+		"square centimeters": UnitSquareCentiMeters,
+		"sq dm":              UnitSquareDeciMeters,
+		"square decimeters":  UnitSquareDeciMeters,
+		"sq m":               UnitSquareMeters,
+		"sqm":                UnitSquareMeters,
+		"square meter":       UnitSquareMeters,
+		"square meters":      UnitSquareMeters,
+		"square metre":       UnitSquareMeters,
+		"square metres":      UnitSquareMeters,
+		"are":                UnitAres,
+		"ares":               UnitAres,
+		"hectare":            UnitHectares,
+		"hectares":           UnitHectares,
+		"sq km":              UnitSquareKiloMeters,
+		"square kilometers":  UnitSquareKiloMeters,
+		"sq in":              UnitSquareInches,
+		"square inch":        UnitSquareInches,
+		"square inches":      UnitSquareInches,
+		"sq ft":              UnitSquareFeet,
+		"sqft":               UnitSquareFeet,
+		"square foot":        UnitSquareFeet,
+		"square feet":        UnitSquareFeet,
+		"sq yd":              UnitSquareYards,
+		"square yard":        UnitSquareYards,
+		"square yards":       UnitSquareYards,
+		"acre":               UnitAcres,
+		"acres":              UnitAcres,
+		"sq mi":              UnitSquareMiles,
+		"square mile":        UnitSquareMiles,
+		"square miles":       UnitSquareMiles,
+	},
+	Temperature: map[string]UnitTemperature{
+		"deg C":              UnitCelsius,
+		"degrees C":          UnitCelsius,
+		"celsius":            UnitCelsius,
+		"degrees celsius":    UnitCelsius,
+		"centigrade":         UnitCelsius,
+		"deg F":              UnitFahrenheit,
+		"degrees F":          UnitFahrenheit,
+		"fahrenheit":         UnitFahrenheit,
+		"degrees fahrenheit": UnitFahrenheit,
+		"kelvin":             UnitKelvin,
+		"kelvins":            UnitKelvin,
+		"rankine":            UnitRankine,
+		"degrees rankine":    UnitRankine,
+	},
+	Time: map[string]UnitTime{
+		"nanosecond":   UnitNanoSeconds,
+		"nanoseconds":  UnitNanoSeconds,
+		"mcs":          UnitMicroSeconds,
+		"microsecond":  UnitMicroSeconds,
+		"microseconds": UnitMicroSeconds,
+		"millisecond":  UnitMilliSeconds,
+		"milliseconds": UnitMilliSeconds,
+		"sec":          UnitSeconds,
+		"secs":         UnitSeconds,
+		"second":       UnitSeconds,
+		"seconds":      UnitSeconds,
+		"mins":         UnitMinutes,
+		"minute":       UnitMinutes,
+		"minutes":      UnitMinutes,
+		"hr":           UnitHours,
+		"hrs":          UnitHours,
+		"hour":         UnitHours,
+		"hours":        UnitHours,
+		"day":          UnitDays,
+		"days":         UnitDays,
+		"w":            UnitWeeks,
+		"wks":          UnitWeeks,
+		"week":         UnitWeeks,
+		"weeks":        UnitWeeks,
+		"mos":          UnitMonths,
+		"month":        UnitMonths,
+		"months":       UnitMonths,
+		"y":            UnitYears,
+		"yrs":          UnitYears,
+		"year":         UnitYears,
+		"years":        UnitYears,
+	},
+	Energy: map[string]UnitEnergy{
+		"joule":                 UnitJoules,
+		"joules":                UnitJoules,
+		"kilojoule":             UnitKiloJoules,
+		"kilojoules":            UnitKiloJoules,
+		"megajoule":             UnitMegaJoules,
+		"megajoules":            UnitMegaJoules,
+		"calorie":               UnitCalories,
+		"calories":              UnitCalories,
+		"Cal":                   UnitKiloCalories,
+		"kcals":                 UnitKiloCalories,
+		"kilocalorie":           UnitKiloCalories,
+		"kilocalories":          UnitKiloCalories,
+		"watt hour":             UnitWattHours,
+		"watt hours":            UnitWattHours,
+		"kilowatt hour":         UnitKiloWattHours,
+		"kilowatt hours":        UnitKiloWattHours,
+		"Btu":                   UnitBritishThermalUnits,
+		"BTUs":                  UnitBritishThermalUnits,
+		"British thermal units": UnitBritishThermalUnits,
+		"therm":                 UnitTherms,
+		"therms":                UnitTherms,
+	},
+	Power: map[string]UnitPower{
+		"milliwatt":         UnitMilliWatts,
+		"milliwatts":        UnitMilliWatts,
+		"watt":              UnitWatts,
+		"watts":             UnitWatts,
+		"kilowatt":          UnitKiloWatts,
+		"kilowatts":         UnitKiloWatts,
+		"megawatt":          UnitMegaWatts,
+		"megawatts":         UnitMegaWatts,
+		"gigawatt":          UnitGigaWatts,
+		"gigawatts":         UnitGigaWatts,
+		"horsepower":        UnitHorsepowerMechanical,
+		"bhp":               UnitHorsepowerMechanical,
+		"metric horsepower": UnitHorsepowerMetric,
+		"BTU/hr":            UnitBritishThermalUnitsPerHour,
+		"BTU per hour":      UnitBritishThermalUnitsPerHour,
+	},
+	Pressure: map[string]UnitPressure{
+		"pascal":                 UnitPascals,
+		"pascals":                UnitPascals,
+		"hectopascal":            UnitHectoPascals,
+		"hectopascals":           UnitHectoPascals,
+		"kilopascal":             UnitKiloPascals,
+		"kilopascals":            UnitKiloPascals,
+		"megapascal":             UnitMegaPascals,
+		"megapascals":            UnitMegaPascals,
+		"millibar":               UnitMilliBars,
+		"millibars":              UnitMilliBars,
+		"bars":                   UnitBars,
+		"atmosphere":             UnitAtmospheres,
+		"atmospheres":            UnitAtmospheres,
+		"lbf/in2":                UnitPoundsPerSquareInch,
+		"pounds per square inch": UnitPoundsPerSquareInch,
+		"mm Hg":                  UnitMillimetersOfMercury,
+		"millimeters of mercury": UnitMillimetersOfMercury,
+		"in Hg":                  UnitInchesOfMercury,
+		"inches of mercury":      UnitInchesOfMercury,
+	},
+	Speed: map[string]UnitSpeed{
+		"m/sec":               UnitMetersPerSecond,
+		"meters per second":   UnitMetersPerSecond,
+		"metres per second":   UnitMetersPerSecond,
+		"kph":                 UnitKiloMetersPerHour,
+		"kmh":                 UnitKiloMetersPerHour,
+		"km/hr":               UnitKiloMetersPerHour,
+		"kilometers per hour": UnitKiloMetersPerHour,
+		"kilometres per hour": UnitKiloMetersPerHour,
+		"mi/h":                UnitMilesPerHour,
+		"miles per hour":      UnitMilesPerHour,
+		"kt":                  UnitKnots,
+		"kts":                 UnitKnots,
+		"knot":                UnitKnots,
+		"knots":               UnitKnots,
+		"ft/sec":              UnitFeetPerSecond,
+		"feet per second":     UnitFeetPerSecond,
+	},
+	Density: map[string]UnitDensity{
+		"kg/m^3":                     UnitKiloGramsPerCubicMeter,
+		"kilograms per cubic meter":  UnitKiloGramsPerCubicMeter,
+		"kilograms per cubic metre":  UnitKiloGramsPerCubicMeter,
+		"g/cc":                       UnitGramsPerCubicCentiMeter,
+		"grams per cubic centimeter": UnitGramsPerCubicCentiMeter,
+		"grams per milliliter":       UnitGramsPerMilliLiter,
+		"grams per millilitre":       UnitGramsPerMilliLiter,
+		"kilograms per liter":        UnitKiloGramsPerLiter,
+		"kilograms per litre":        UnitKiloGramsPerLiter,
+		"grams per liter":            UnitGramsPerLiter,
+		"grams per litre":            UnitGramsPerLiter,
+		"lbs/gal":                    UnitPoundsPerGallon,
+		"pounds per gallon":          UnitPoundsPerGallon,
+		"lb/cu ft":                   UnitPoundsPerCubicFoot,
+		"pounds per cubic foot":      UnitPoundsPerCubicFoot,
+	},
+	FlowRate: map[string]UnitFlowRate{
+		"ml/minute":                   UnitMilliLitersPerMinute,
+		"milliliters per minute":      UnitMilliLitersPerMinute,
+		"lps":                         UnitLitersPerSecond,
+		"liters per second":           UnitLitersPerSecond,
+		"litres per second":           UnitLitersPerSecond,
+		"lpm":                         UnitLitersPerMinute,
+		"l/minute":                    UnitLitersPerMinute,
+		"liters per minute":           UnitLitersPerMinute,
+		"litres per minute":           UnitLitersPerMinute,
+		"lph":                         UnitLitersPerHour,
+		"l/hr":                        UnitLitersPerHour,
+		"liters per hour":             UnitLitersPerHour,
+		"litres per hour":             UnitLitersPerHour,
+		"cubic meters per second":     UnitCubicMetersPerSecond,
+		"m3/hr":                       UnitCubicMetersPerHour,
+		"cubic meters per hour":       UnitCubicMetersPerHour,
+		"gpm":                         UnitGallonsPerMinute,
+		"US gpm":                      UnitGallonsPerMinute,
+		"gallons per minute":          UnitGallonsPerMinute,
+		"igpm":                        UnitImperialGallonsPerMinute,
+		"imp gpm":                     UnitImperialGallonsPerMinute,
+		"imperial gallons per minute": UnitImperialGallonsPerMinute,
+		"cfm":                         UnitCubicFeetPerMinute,
+		"cu ft/min":                   UnitCubicFeetPerMinute,
+		"cubic feet per minute":       UnitCubicFeetPerMinute,
+	},
+	Concentration: map[string]UnitConcentration{
+		"micrograms per liter":      UnitMicrogramsPerLiter,
+		"micrograms per litre":      UnitMicrogramsPerLiter,
+		"milligrams per liter":      UnitMilligramsPerLiter,
+		"milligrams per litre":      UnitMilligramsPerLiter,
+		"milligrams per milliliter": UnitMilligramsPerMilliLiter,
+		"grams per 100 ml":          UnitGramsPer100MilliLiters,
+		"% w/v":                     UnitPercentMassPerVolume,
+		"milliliters per liter":     UnitMilliLitersPerLiter,
+		"% v/v":                     UnitPercentVolumePerVolume,
+		"% vol":                     UnitPercentVolumePerVolume,
+		"% ABV":                     UnitPercentVolumePerVolume,
+		"ABV":                       UnitPercentVolumePerVolume,
+		"milligrams per kilogram":   UnitMilligramsPerKilogram,
+		"grams per kilogram":        UnitGramsPerKilogram,
+		"grams per 100 g":           UnitGramsPer100Grams,
+		"percent":                   UnitPercent,
+		"% w/w":                     UnitPercent,
+		"parts per million":         UnitPartsPerMillion,
+		"parts per billion":         UnitPartsPerBillion,
+	},
+}
+
+var LanguageRussian = Language{
+	Decimal: ',',
+	Group:   ' ',
+	Mass: map[string]UnitMass{
+		"пг":          UnitPicograms,
+		"нг":          UnitNanograms,
+		"мкг":         UnitMicrograms,
+		"мг":          UnitMilligrams,
+		"сг":          UnitCentigrams,
+		"дг":          UnitDecigrams,
+		"г":           UnitGrams,
+		"гр":          UnitGrams,
+		"грамм":       UnitGrams,
+		"граммов":     UnitGrams,
+		"кг":          UnitKilograms,
+		"килограмм":   UnitKilograms,
+		"килограммов": UnitKilograms,
+		"унц":         UnitOunces,
+		"унция":       UnitOunces,
+		"унций":       UnitOunces,
+		"фунт":        UnitPounds,
+		"фунтов":      UnitPounds,
+		"стоун":       UnitStones,
+		"стоунов":     UnitStones,
+		"т":           UnitMetricTons,
+		"тонна":       UnitMetricTons,
+		"тонн":        UnitMetricTons,
+		"кор. т":      UnitShortTons,
+		"кар":         UnitCarats,
+		"карат":       UnitCarats,
+		"тр. унц":     UnitOuncesTroy,
+		"слаг":        UnitSlugs,
+	},
+	Volume: map[string]UnitVolume{
+		"мл":              UnitMilliLiters,
+		"сл":              UnitCentiLiters,
+		"дл":              UnitDeciLiters,
+		"л":               UnitLiters,
+		"литр":            UnitLiters,
+		"литра":           UnitLiters,
+		"литров":          UnitLiters,
+		"кл":              UnitKiloLiters,
+		"Мл":              UnitMegaLiters,
+		"мм3":             UnitCubicMilliMeters,
+		"см3":             UnitCubicCentiMeters,
+		"дм3":             UnitCubicDeciMeters,
+		"куб. фут":        UnitCubicFeet,
+		"куб. дюйм":       UnitCubicInches,
+		"м3":              UnitCubicMeters,
+		"км3":             UnitCubicKiloMeters,
+		"куб. миля":       UnitCubicMiles,
+		"куб. ярд":        UnitCubicYards,
+		"бушель":          UnitBushels,
+		"чашка":           UnitCups,
+		"жидк. унц":       UnitFluidOunces,
+		"галлон":          UnitGallons,
+		"пинта":           UnitPints,
+		"кварта":          UnitQuarts,
+		"ст. л.":          UnitTablespoons,
+		"ч. л.":           UnitTeaspoons,
+		"брит. жидк. унц": UnitImperialFluidOunces,
+		"брит. галлон":    UnitImperialGallons,
+		"брит. гилл":      UnitImperialGills,
+		"брит. пинта":     UnitImperialPints,
+		"брит. кварта":    UnitImperialQuarts,
+		"брит. ст. л.":    UnitImperialTablespoons,
+		"брит. ч. л.":     UnitImperialTeaspoons,
+	},
+	Length: map[string]UnitLength{
+		"мм":           UnitMilliMeters,
+		"см":           UnitCentiMeters,
+		"дм":           UnitDeciMeters,
+		"м":            UnitMeters,
+		"метр":         UnitMeters,
+		"метра":        UnitMeters,
+		"метров":       UnitMeters,
+		"км":           UnitKiloMeters,
+		"километр":     UnitKiloMeters,
+		"километров":   UnitKiloMeters,
+		"дюйм":         UnitInches,
+		"дюймов":       UnitInches,
+		"фут":          UnitFeet,
+		"футов":        UnitFeet,
+		"ярд":          UnitYards,
+		"ярдов":        UnitYards,
+		"миля":         UnitMiles,
+		"миль":         UnitMiles,
+		"морская миля": UnitNauticalMiles,
+		"морских миль": UnitNauticalMiles,
+	},
+	Area: map[string]UnitArea{
+		"мм2":      UnitSquareMilliMeters,
+		"кв. мм":   UnitSquareMilliMeters,
+		"см2":      UnitSquareCentiMeters,
+		"кв. см":   UnitSquareCentiMeters,
+		"дм2":      UnitSquareDeciMeters,
+		"кв. дм":   UnitSquareDeciMeters,
+		"м2":       UnitSquareMeters,
+		"кв. м":    UnitSquareMeters,
+		"ар":       UnitAres,
+		"сотка":    UnitAres,
+		"соток":    UnitAres,
+		"га":       UnitHectares,
+		"гектар":   UnitHectares,
+		"гектаров": UnitHectares,
+		"км2":      UnitSquareKiloMeters,
+		"кв. км":   UnitSquareKiloMeters,
+		"кв. дюйм": UnitSquareInches,
+		"кв. фут":  UnitSquareFeet,
+		"кв. ярд":  UnitSquareYards,
+		"акр":      UnitAcres,
+		"акров":    UnitAcres,
+		"кв. миля": UnitSquareMiles,
+	},
+	Temperature: map[string]UnitTemperature{
+		"С":                   UnitCelsius,
+		"градусов Цельсия":    UnitCelsius,
+		"Ф":                   UnitFahrenheit,
+		"градусов Фаренгейта": UnitFahrenheit,
+		"К":                   UnitKelvin,
+		"кельвин":             UnitKelvin,
+		"кельвинов":           UnitKelvin,
+		"Р":                   UnitRankine,
+		"градусов Ранкина":    UnitRankine,
+	},
+	Time: map[string]UnitTime{
+		"нс":      UnitNanoSeconds,
+		"мкс":     UnitMicroSeconds,
+		"мс":      UnitMilliSeconds,
+		"с":       UnitSeconds,
+		"сек":     UnitSeconds,
+		"секунд":  UnitSeconds,
+		"мин":     UnitMinutes,
+		"минут":   UnitMinutes,
+		"ч":       UnitHours,
+		"час":     UnitHours,
+		"часа":    UnitHours,
+		"часов":   UnitHours,
+		"дн":      UnitDays,
+		"день":    UnitDays,
+		"дня":     UnitDays,
+		"дней":    UnitDays,
+		"сут":     UnitDays,
+		"нед":     UnitWeeks,
+		"неделя":  UnitWeeks,
+		"недели":  UnitWeeks,
+		"недель":  UnitWeeks,
+		"мес":     UnitMonths,
+		"месяц":   UnitMonths,
+		"месяца":  UnitMonths,
+		"месяцев": UnitMonths,
+		"год":     UnitYears,
+		"года":    UnitYears,
+		"лет":     UnitYears,
+	},
+	Energy: map[string]UnitEnergy{
+		"Дж":          UnitJoules,
+		"джоуль":      UnitJoules,
+		"кДж":         UnitKiloJoules,
+		"МДж":         UnitMegaJoules,
+		"кал":         UnitCalories,
+		"калорий":     UnitCalories,
+		"ккал":        UnitKiloCalories,
+		"килокалорий": UnitKiloCalories,
+		"Вт·ч":        UnitWattHours,
+		"Втч":         UnitWattHours,
+		"кВт·ч":       UnitKiloWattHours,
+		"кВтч":        UnitKiloWattHours,
+		"БТЕ":         UnitBritishThermalUnits,
+		"терм":        UnitTherms,
+	},
+	Power: map[string]UnitPower{
+		"мВт":           UnitMilliWatts,
+		"Вт":            UnitWatts,
+		"ватт":          UnitWatts,
+		"кВт":           UnitKiloWatts,
+		"киловатт":      UnitKiloWatts,
+		"МВт":           UnitMegaWatts,
+		"ГВт":           UnitGigaWatts,
+		"л.с.":          UnitHorsepowerMetric,
+		"лошадиных сил": UnitHorsepowerMetric,
+		"мех. л.с.":     UnitHorsepowerMechanical,
+		"БТЕ/ч":         UnitBritishThermalUnitsPerHour,
+	},
+	Pressure: map[string]UnitPressure{
+		"Па":            UnitPascals,
+		"гПа":           UnitHectoPascals,
+		"кПа":           UnitKiloPascals,
+		"МПа":           UnitMegaPascals,
+		"мбар":          UnitMilliBars,
+		"бар":           UnitBars,
+		"атм":           UnitAtmospheres,
+		"фунт/кв. дюйм": UnitPoundsPerSquareInch,
+		"мм рт. ст.":    UnitMillimetersOfMercury,
+		"дюйм рт. ст.":  UnitInchesOfMercury,
+	},
+	Speed: map[string]UnitSpeed{
+		"м/с":    UnitMetersPerSecond,
+		"км/ч":   UnitKiloMetersPerHour,
+		"миль/ч": UnitMilesPerHour,
+		"уз":     UnitKnots,
+		"узел":   UnitKnots,
+		"узла":   UnitKnots,
+		"узлов":  UnitKnots,
+		"фут/с":  UnitFeetPerSecond,
+	},
+	Density: map[string]UnitDensity{
+		"кг/м3":     UnitKiloGramsPerCubicMeter,
+		"г/см3":     UnitGramsPerCubicCentiMeter,
+		"г/мл":      UnitGramsPerMilliLiter,
+		"кг/л":      UnitKiloGramsPerLiter,
+		"г/л":       UnitGramsPerLiter,
+		"фунт/гал":  UnitPoundsPerGallon,
+		"фунт/фут3": UnitPoundsPerCubicFoot,
+	},
+	FlowRate: map[string]UnitFlowRate{
+		"мл/мин":           UnitMilliLitersPerMinute,
+		"л/с":              UnitLitersPerSecond,
+		"л/мин":            UnitLitersPerMinute,
+		"л/ч":              UnitLitersPerHour,
+		"м3/с":             UnitCubicMetersPerSecond,
+		"м3/ч":             UnitCubicMetersPerHour,
+		"галлон/мин":       UnitGallonsPerMinute,
+		"брит. галлон/мин": UnitImperialGallonsPerMinute,
+		"фут3/мин":         UnitCubicFeetPerMinute,
+	},
+	Concentration: map[string]UnitConcentration{
+		"мкг/л":              UnitMicrogramsPerLiter,
+		"мг/л":               UnitMilligramsPerLiter,
+		"мг/мл":              UnitMilligramsPerMilliLiter,
+		"г/100 мл":           UnitGramsPer100MilliLiters,
+		"% м/о":              UnitPercentMassPerVolume,
+		"мл/л":               UnitMilliLitersPerLiter,
+		"% об.":              UnitPercentVolumePerVolume,
+		"мг/кг":              UnitMilligramsPerKilogram,
+		"г/кг":               UnitGramsPerKilogram,
+		"г/100 г":            UnitGramsPer100Grams,
+		"процент":            UnitPercent,
+		"процентов":          UnitPercent,
+		"частей на миллион":  UnitPartsPerMillion,
+		"частей на миллиард": UnitPartsPerBillion,
+	},
+}
+
+var LanguageChinese = Language{
+	Decimal: '.',
+	Group:   ',',
+	Mass: map[string]UnitMass{
+		"皮克":   UnitPicograms,
+		"纳克":   UnitNanograms,
+		"微克":   UnitMicrograms,
+		"毫克":   UnitMilligrams,
+		"厘克":   UnitCentigrams,
+		"分克":   UnitDecigrams,
+		"克":    UnitGrams,
+		"千克":   UnitKilograms,
+		"公斤":   UnitKilograms,
+		"盎司":   UnitOunces,
+		"磅":    UnitPounds,
+		"英石":   UnitStones,
+		"吨":    UnitMetricTons,
+		"公吨":   UnitMetricTons,
+		"短吨":   UnitShortTons,
+		"克拉":   UnitCarats,
+		"金衡盎司": UnitOuncesTroy,
+		"斯勒格":  UnitSlugs,
+	},
+	Volume: map[string]UnitVolume{
+		"毫升":     UnitMilliLiters,
+		"厘升":     UnitCentiLiters,
+		"分升":     UnitDeciLiters,
+		"升":      UnitLiters,
+		"公升":     UnitLiters,
+		"千升":     UnitKiloLiters,
+		"兆升":     UnitMegaLiters,
+		"立方毫米":   UnitCubicMilliMeters,
+		"立方厘米":   UnitCubicCentiMeters,
+		"立方分米":   UnitCubicDeciMeters,
+		"立方英尺":   UnitCubicFeet,
+		"立方英寸":   UnitCubicInches,
+		"立方米":    UnitCubicMeters,
+		"立方千米":   UnitCubicKiloMeters,
+		"立方公里":   UnitCubicKiloMeters,
+		"立方英里":   UnitCubicMiles,
+		"立方码":    UnitCubicYards,
+		"蒲式耳":    UnitBushels,
+		"杯":      UnitCups,
+		"液量盎司":   UnitFluidOunces,
+		"加仑":     UnitGallons,
+		"品脱":     UnitPints,
+		"夸脱":     UnitQuarts,
+		"汤匙":     UnitTablespoons,
+		"茶匙":     UnitTeaspoons,
+		"英制液量盎司": UnitImperialFluidOunces,
+		"英制加仑":   UnitImperialGallons,
+		"英制及耳":   UnitImperialGills,
+		"英制品脱":   UnitImperialPints,
+		"英制夸脱":   UnitImperialQuarts,
+		"英制汤匙":   UnitImperialTablespoons,
+		"英制茶匙":   UnitImperialTeaspoons,
+	},
+	Length: map[string]UnitLength{
+		"毫米": UnitMilliMeters,
+		"厘米": UnitCentiMeters,
+		"分米": UnitDeciMeters,
+		"米":  UnitMeters,
+		"千米": UnitKiloMeters,
+		"公里": UnitKiloMeters,
+		"英寸": UnitInches,
+		"英尺": UnitFeet,
+		"码":  UnitYards,
+		"英里": UnitMiles,
+		"海里": UnitNauticalMiles,
+	},
+	Area: map[string]UnitArea{
+		"平方毫米": UnitSquareMilliMeters,
+		"平方厘米": UnitSquareCentiMeters,
+		"平方分米": UnitSquareDeciMeters,
+		"平方米":  UnitSquareMeters,
+		"公亩":   UnitAres,
+		"公顷":   UnitHectares,
+		"平方千米": UnitSquareKiloMeters,
+		"平方公里": UnitSquareKiloMeters,
+		"平方英寸": UnitSquareInches,
+		"平方英尺": UnitSquareFeet,
+		"平方码":  UnitSquareYards,
+		"英亩":   UnitAcres,
+		"平方英里": UnitSquareMiles,
+	},
+	Temperature: map[string]UnitTemperature{
+		"摄氏度": UnitCelsius,
+		"华氏度": UnitFahrenheit,
+		"开尔文": UnitKelvin,
+		"开":   UnitKelvin,
+		"兰氏度": UnitRankine,
+	},
+	Time: map[string]UnitTime{
+		"纳秒": UnitNanoSeconds,
+		"微秒": UnitMicroSeconds,
+		"毫秒": UnitMilliSeconds,
+		"秒":  UnitSeconds,
+		"分钟": UnitMinutes,
+		"小时": UnitHours,
+		"天":  UnitDays,
+		"日":  UnitDays,
+		"周":  UnitWeeks,
+		"星期": UnitWeeks,
+		"个月": UnitMonths,
+		"月":  UnitMonths,
+		"年":  UnitYears,
+	},
+	Energy: map[string]UnitEnergy{
+		"焦耳":   UnitJoules,
+		"焦":    UnitJoules,
+		"千焦":   UnitKiloJoules,
+		"兆焦":   UnitMegaJoules,
+		"卡":    UnitCalories,
+		"卡路里":  UnitCalories,
+		"千卡":   UnitKiloCalories,
+		"大卡":   UnitKiloCalories,
+		"瓦时":   UnitWattHours,
+		"千瓦时":  UnitKiloWattHours,
+		"度":    UnitKiloWattHours,
+		"英热单位": UnitBritishThermalUnits,
+		"撒姆":   UnitTherms,
+	},
+	Power: map[string]UnitPower{
+		"毫瓦":      UnitMilliWatts,
+		"瓦":       UnitWatts,
+		"瓦特":      UnitWatts,
+		"千瓦":      UnitKiloWatts,
+		"兆瓦":      UnitMegaWatts,
+		"吉瓦":      UnitGigaWatts,
+		"马力":      UnitHorsepowerMetric,
+		"公制马力":    UnitHorsepowerMetric,
+		"英制马力":    UnitHorsepowerMechanical,
+		"英热单位/小时": UnitBritishThermalUnitsPerHour,
+	},
+	Pressure: map[string]UnitPressure{
+		"帕":      UnitPascals,
+		"帕斯卡":    UnitPascals,
+		"百帕":     UnitHectoPascals,
+		"千帕":     UnitKiloPascals,
+		"兆帕":     UnitMegaPascals,
+		"毫巴":     UnitMilliBars,
+		"巴":      UnitBars,
+		"大气压":    UnitAtmospheres,
+		"标准大气压":  UnitAtmospheres,
+		"磅/平方英寸": UnitPoundsPerSquareInch,
+		"毫米汞柱":   UnitMillimetersOfMercury,
+		"英寸汞柱":   UnitInchesOfMercury,
+	},
+	Speed: map[string]UnitSpeed{
+		"米/秒":   UnitMetersPerSecond,
+		"公里/小时": UnitKiloMetersPerHour,
+		"千米/小时": UnitKiloMetersPerHour,
+		"英里/小时": UnitMilesPerHour,
+		"节":     UnitKnots,
+		"英尺/秒":  UnitFeetPerSecond,
+	},
+	Density: map[string]UnitDensity{
+		"千克/立方米": UnitKiloGramsPerCubicMeter,
+		"克/立方厘米": UnitGramsPerCubicCentiMeter,
+		"克/毫升":   UnitGramsPerMilliLiter,
+		"千克/升":   UnitKiloGramsPerLiter,
+		"克/升":    UnitGramsPerLiter,
+		"磅/加仑":   UnitPoundsPerGallon,
+		"磅/立方英尺": UnitPoundsPerCubicFoot,
+	},
+	FlowRate: map[string]UnitFlowRate{
+		"毫升/分钟":   UnitMilliLitersPerMinute,
+		"升/秒":     UnitLitersPerSecond,
+		"升/分钟":    UnitLitersPerMinute,
+		"升/小时":    UnitLitersPerHour,
+		"立方米/秒":   UnitCubicMetersPerSecond,
+		"立方米/小时":  UnitCubicMetersPerHour,
+		"加仑/分钟":   UnitGallonsPerMinute,
+		"英制加仑/分钟": UnitImperialGallonsPerMinute,
+		"立方英尺/分钟": UnitCubicFeetPerMinute,
+	},
+	Concentration: map[string]UnitConcentration{
+		"微克/升":    UnitMicrogramsPerLiter,
+		"毫克/升":    UnitMilligramsPerLiter,
+		"毫克/毫升":   UnitMilligramsPerMilliLiter,
+		"克/100毫升": UnitGramsPer100MilliLiters,
+		"质量体积百分比": UnitPercentMassPerVolume,
+		"毫升/升":    UnitMilliLitersPerLiter,
+		"体积百分比":   UnitPercentVolumePerVolume,
+		"毫克/千克":   UnitMilligramsPerKilogram,
+		"克/千克":    UnitGramsPerKilogram,
+		"克/100克":  UnitGramsPer100Grams,
+		"质量百分比":   UnitPercent,
+		"百万分之":    UnitPartsPerMillion,
+		"十亿分之":    UnitPartsPerBillion,
+	},
+}
+
+var LanguageJapanese = Language{
+	Decimal: '.',
+	Group:   ',',
+	Mass: map[string]UnitMass{
+		"ピコグラム":   UnitPicograms,
+		"ナノグラム":   UnitNanograms,
+		"マイクログラム": UnitMicrograms,
+		"ミリグラム":   UnitMilligrams,
+		"センチグラム":  UnitCentigrams,
+		"デシグラム":   UnitDecigrams,
+		"グラム":     UnitGrams,
+		"キログラム":   UnitKilograms,
+		"キロ":      UnitKilograms,
+		"オンス":     UnitOunces,
+		"ポンド":     UnitPounds,
+		"ストーン":    UnitStones,
+		"トン":      UnitMetricTons,
+		"米トン":     UnitShortTons,
+		"カラット":    UnitCarats,
+		"トロイオンス":  UnitOuncesTroy,
+		"スラグ":     UnitSlugs,
+	},
+	Volume: map[string]UnitVolume{
+		"ミリリットル":    UnitMilliLiters,
+		"センチリットル":   UnitCentiLiters,
+		"デシリットル":    UnitDeciLiters,
+		"リットル":      UnitLiters,
+		"キロリットル":    UnitKiloLiters,
+		"メガリットル":    UnitMegaLiters,
+		"立方ミリメートル":  UnitCubicMilliMeters,
+		"立方センチメートル": UnitCubicCentiMeters,
+		"立方デシメートル":  UnitCubicDeciMeters,
+		"立方フィート":    UnitCubicFeet,
+		"立方インチ":     UnitCubicInches,
+		"立方メートル":    UnitCubicMeters,
+		"立方キロメートル":  UnitCubicKiloMeters,
+		"立方マイル":     UnitCubicMiles,
+		"立方ヤード":     UnitCubicYards,
+		"ブッシェル":     UnitBushels,
+		"カップ":       UnitCups,
+		"液量オンス":     UnitFluidOunces,
+		"ガロン":       UnitGallons,
+		"パイント":      UnitPints,
+		"クォート":      UnitQuarts,
+		"大さじ":       UnitTablespoons,
+		"小さじ":       UnitTeaspoons,
+		"英液量オンス":    UnitImperialFluidOunces,
+		"英ガロン":      UnitImperialGallons,
+		"英ジル":       UnitImperialGills,
+		"英パイント":     UnitImperialPints,
+		"英クォート":     UnitImperialQuarts,
+		"英大さじ":      UnitImperialTablespoons,
+		"英小さじ":      UnitImperialTeaspoons,
+	},
+	Length: map[string]UnitLength{
+		"ミリメートル":  UnitMilliMeters,
+		"ミリ":      UnitMilliMeters,
+		"センチメートル": UnitCentiMeters,
+		"センチ":     UnitCentiMeters,
+		"デシメートル":  UnitDeciMeters,
+		"メートル":    UnitMeters,
+		"キロメートル":  UnitKiloMeters,
+		"インチ":     UnitInches,
+		"フィート":    UnitFeet,
+		"ヤード":     UnitYards,
+		"マイル":     UnitMiles,
+		"海里":      UnitNauticalMiles,
+	},
+	Area: map[string]UnitArea{
+		"平方ミリメートル":  UnitSquareMilliMeters,
+		"平方センチメートル": UnitSquareCentiMeters,
+		"平方デシメートル":  UnitSquareDeciMeters,
+		"平方メートル":    UnitSquareMeters,
+		"アール":       UnitAres,
+		"ヘクタール":     UnitHectares,
+		"平方キロメートル":  UnitSquareKiloMeters,
+		"平方インチ":     UnitSquareInches,
+		"平方フィート":    UnitSquareFeet,
+		"平方ヤード":     UnitSquareYards,
+		"エーカー":      UnitAcres,
+		"平方マイル":     UnitSquareMiles,
+	},
+	Temperature: map[string]UnitTemperature{
+		"摂氏":   UnitCelsius,
+		"度":    UnitCelsius,
+		"華氏":   UnitFahrenheit,
+		"ケルビン": UnitKelvin,
+		"ランキン": UnitRankine,
+	},
+	Time: map[string]UnitTime{
+		"ナノ秒":   UnitNanoSeconds,
+		"マイクロ秒": UnitMicroSeconds,
+		"ミリ秒":   UnitMilliSeconds,
+		"秒":     UnitSeconds,
+		"分":     UnitMinutes,
+		"時間":    UnitHours,
+		"日":     UnitDays,
+		"週":     UnitWeeks,
+		"週間":    UnitWeeks,
+		"か月":    UnitMonths,
+		"ヶ月":    UnitMonths,
+		"年":     UnitYears,
+	},
+	Energy: map[string]UnitEnergy{
+		"ジュール":   UnitJoules,
+		"キロジュール": UnitKiloJoules,
+		"メガジュール": UnitMegaJoules,
+		"カロリー":   UnitCalories,
+		"キロカロリー": UnitKiloCalories,
+		"ワット時":   UnitWattHours,
+		"キロワット時": UnitKiloWattHours,
+		"英熱量":    UnitBritishThermalUnits,
+		"サーム":    UnitTherms,
+	},
+	Power: map[string]UnitPower{
+		"ミリワット": UnitMilliWatts,
+		"ワット":   UnitWatts,
+		"キロワット": UnitKiloWatts,
+		"メガワット": UnitMegaWatts,
+		"ギガワット": UnitGigaWatts,
+		"馬力":    UnitHorsepowerMetric,
+		"仏馬力":   UnitHorsepowerMetric,
+		"英馬力":   UnitHorsepowerMechanical,
+		"BTU/時": UnitBritishThermalUnitsPerHour,
+	},
+	Pressure: map[string]UnitPressure{
+		"パスカル":        UnitPascals,
+		"ヘクトパスカル":     UnitHectoPascals,
+		"キロパスカル":      UnitKiloPascals,
+		"メガパスカル":      UnitMegaPascals,
+		"ミリバール":       UnitMilliBars,
+		"バール":         UnitBars,
+		"気圧":          UnitAtmospheres,
+		"重量ポンド毎平方インチ": UnitPoundsPerSquareInch,
+		"水銀柱ミリメートル":   UnitMillimetersOfMercury,
+		"水銀柱インチ":      UnitInchesOfMercury,
+	},
+	Speed: map[string]UnitSpeed{
+		"メートル毎秒":   UnitMetersPerSecond,
+		"キロメートル毎時": UnitKiloMetersPerHour,
+		"km/時":     UnitKiloMetersPerHour,
+		"マイル毎時":    UnitMilesPerHour,
+		"ノット":      UnitKnots,
+		"フィート毎秒":   UnitFeetPerSecond,
+	},
+	Density: map[string]UnitDensity{
+		"キログラム毎立方メートル":  UnitKiloGramsPerCubicMeter,
+		"グラム毎立方センチメートル": UnitGramsPerCubicCentiMeter,
+		"グラム毎ミリリットル":    UnitGramsPerMilliLiter,
+		"キログラム毎リットル":    UnitKiloGramsPerLiter,
+		"グラム毎リットル":      UnitGramsPerLiter,
+		"ポンド毎ガロン":       UnitPoundsPerGallon,
+		"ポンド毎立方フィート":    UnitPoundsPerCubicFoot,
+	},
+	FlowRate: map[string]UnitFlowRate{
+		"ミリリットル毎分": UnitMilliLitersPerMinute,
+		"リットル毎秒":   UnitLitersPerSecond,
+		"リットル毎分":   UnitLitersPerMinute,
+		"リットル毎時":   UnitLitersPerHour,
+		"立方メートル毎秒": UnitCubicMetersPerSecond,
+		"立方メートル毎時": UnitCubicMetersPerHour,
+		"ガロン毎分":    UnitGallonsPerMinute,
+		"英ガロン毎分":   UnitImperialGallonsPerMinute,
+		"立方フィート毎分": UnitCubicFeetPerMinute,
+	},
+	Concentration: map[string]UnitConcentration{
+		"マイクログラム毎リットル":  UnitMicrogramsPerLiter,
+		"ミリグラム毎リットル":    UnitMilligramsPerLiter,
+		"ミリグラム毎ミリリットル":  UnitMilligramsPerMilliLiter,
+		"グラム毎100ミリリットル": UnitGramsPer100MilliLiters,
+		"w/v%":          UnitPercentMassPerVolume,
+		"ミリリットル毎リットル":   UnitMilliLitersPerLiter,
+		"vol%":          UnitPercentVolumePerVolume,
+		"v/v%":          UnitPercentVolumePerVolume,
+		"ミリグラム毎キログラム":   UnitMilligramsPerKilogram,
+		"グラム毎キログラム":     UnitGramsPerKilogram,
+		"グラム毎100グラム":    UnitGramsPer100Grams,
+		"パーセント":         UnitPercent,
+		"百万分率":          UnitPartsPerMillion,
+		"十億分率":          UnitPartsPerBillion,
+	},
+}
+
+var LanguageSpanish = Language{
+	Decimal: ',',
+	Group:   '.',
+	Mass: map[string]UnitMass{
+		"picogramos":       UnitPicograms,
+		"nanogramos":       UnitNanograms,
+		"microgramos":      UnitMicrograms,
+		"miligramos":       UnitMilligrams,
+		"centigramos":      UnitCentigrams,
+		"decigramos":       UnitDecigrams,
+		"gramo":            UnitGrams,
+		"gramos":           UnitGrams,
+		"kilo":             UnitKilograms,
+		"kilos":            UnitKilograms,
+		"kilogramo":        UnitKilograms,
+		"kilogramos":       UnitKilograms,
+		"onza":             UnitOunces,
+		"onzas":            UnitOunces,
+		"libra":            UnitPounds,
+		"libras":           UnitPounds,
+		"stones":           UnitStones,
+		"tonelada":         UnitMetricTons,
+		"toneladas":        UnitMetricTons,
+		"toneladas cortas": UnitShortTons,
+		"quilate":          UnitCarats,
+		"quilates":         UnitCarats,
+		"onzas troy":       UnitOuncesTroy,
+		"slugs":            UnitSlugs,
+	},
+	Volume: map[string]UnitVolume{
+		"mililitros":                UnitMilliLiters,
+		"centilitros":               UnitCentiLiters,
+		"decilitros":                UnitDeciLiters,
+		"litro":                     UnitLiters,
+		"litros":                    UnitLiters,
+		"kilolitros":                UnitKiloLiters,
+		"megalitros":                UnitMegaLiters,
+		"milímetros cúbicos":        UnitCubicMilliMeters,
+		"centímetros cúbicos":       UnitCubicCentiMeters,
+		"decímetros cúbicos":        UnitCubicDeciMeters,
+		"pies cúbicos":              UnitCubicFeet,
+		"pulgadas cúbicas":          UnitCubicInches,
+		"metros cúbicos":            UnitCubicMeters,
+		"kilómetros cúbicos":        UnitCubicKiloMeters,
+		"millas cúbicas":            UnitCubicMiles,
+		"yardas cúbicas":            UnitCubicYards,
+		"bushels":                   UnitBushels,
+		"taza":                      UnitCups,
+		"tazas":                     UnitCups,
+		"onzas líquidas":            UnitFluidOunces,
+		"galón":                     UnitGallons,
+		"galones":                   UnitGallons,
+		"pinta":                     UnitPints,
+		"pintas":                    UnitPints,
+		"cuartos":                   UnitQuarts,
+		"cucharada":                 UnitTablespoons,
+		"cucharadas":                UnitTablespoons,
+		"cucharadita":               UnitTeaspoons,
+		"cucharaditas":              UnitTeaspoons,
+		"onzas líquidas imperiales": UnitImperialFluidOunces,
+		"galones imperiales":        UnitImperialGallons,
+		"gills imperiales":          UnitImperialGills,
+		"pintas imperiales":         UnitImperialPints,
+		"cuartos imperiales":        UnitImperialQuarts,
+		"cucharadas imperiales":     UnitImperialTablespoons,
+		"cucharaditas imperiales":   UnitImperialTeaspoons,
+	},
+	Length: map[string]UnitLength{
+		"milímetro":       UnitMilliMeters,
+		"milímetros":      UnitMilliMeters,
+		"centímetro":      UnitCentiMeters,
+		"centímetros":     UnitCentiMeters,
+		"decímetro":       UnitDeciMeters,
+		"decímetros":      UnitDeciMeters,
+		"metro":           UnitMeters,
+		"metros":          UnitMeters,
+		"kilómetro":       UnitKiloMeters,
+		"kilómetros":      UnitKiloMeters,
+		"pulgada":         UnitInches,
+		"pulgadas":        UnitInches,
+		"pie":             UnitFeet,
+		"pies":            UnitFeet,
+		"yarda":           UnitYards,
+		"yardas":          UnitYards,
+		"milla":           UnitMiles,
+		"millas":          UnitMiles,
+		"milla náutica":   UnitNauticalMiles,
+		"millas náuticas": UnitNauticalMiles,
+	},
+	Area: map[string]UnitArea{
+		"milímetros cuadrados":  UnitSquareMilliMeters,
+		"centímetros cuadrados": UnitSquareCentiMeters,
+		"decímetros cuadrados":  UnitSquareDeciMeters,
+		"metro cuadrado":        UnitSquareMeters,
+		"metros cuadrados":      UnitSquareMeters,
+		"área":                  UnitAres,
+		"áreas":                 UnitAres,
+		"hectárea":              UnitHectares,
+		"hectáreas":             UnitHectares,
+		"kilómetros cuadrados":  UnitSquareKiloMeters,
+		"pulgadas cuadradas":    UnitSquareInches,
+		"pies cuadrados":        UnitSquareFeet,
+		"yardas cuadradas":      UnitSquareYards,
+		"acre":                  UnitAcres,
+		"acres":                 UnitAcres,
+		"millas cuadradas":      UnitSquareMiles,
+	},
+	Temperature: map[string]UnitTemperature{
+		"grados C":           UnitCelsius,
+		"grados Celsius":     UnitCelsius,
+		"grados centígrados": UnitCelsius,
+		"grados F":           UnitFahrenheit,
+		"grados Fahrenheit":  UnitFahrenheit,
+		"kelvin":             UnitKelvin,
+		"grados Rankine":     UnitRankine,
+	},
+	Time: map[string]UnitTime{
+		"nanosegundos":  UnitNanoSeconds,
+		"microsegundos": UnitMicroSeconds,
+		"milisegundos":  UnitMilliSeconds,
+		"seg":           UnitSeconds,
+		"segundo":       UnitSeconds,
+		"segundos":      UnitSeconds,
+		"minuto":        UnitMinutes,
+		"minutos":       UnitMinutes,
+		"hora":          UnitHours,
+		"horas":         UnitHours,
+		"día":           UnitDays,
+		"días":          UnitDays,
+		"semana":        UnitWeeks,
+		"semanas":       UnitWeeks,
+		"mes":           UnitMonths,
+		"meses":         UnitMonths,
+		"año":           UnitYears,
+		"años":          UnitYears,
+	},
+	Energy: map[string]UnitEnergy{
+		"julio":                        UnitJoules,
+		"julios":                       UnitJoules,
+		"kilojulios":                   UnitKiloJoules,
+		"megajulios":                   UnitMegaJoules,
+		"caloría":                      UnitCalories,
+		"calorías":                     UnitCalories,
+		"kilocaloría":                  UnitKiloCalories,
+		"kilocalorías":                 UnitKiloCalories,
+		"vatios hora":                  UnitWattHours,
+		"kilovatios hora":              UnitKiloWattHours,
+		"unidades térmicas británicas": UnitBritishThermalUnits,
+		"termia":                       UnitTherms,
+		"termias":                      UnitTherms,
+	},
+	Power: map[string]UnitPower{
+		"milivatios":         UnitMilliWatts,
+		"vatio":              UnitWatts,
+		"vatios":             UnitWatts,
+		"kilovatio":          UnitKiloWatts,
+		"kilovatios":         UnitKiloWatts,
+		"megavatios":         UnitMegaWatts,
+		"gigavatios":         UnitGigaWatts,
+		"CV":                 UnitHorsepowerMetric,
+		"caballos de vapor":  UnitHorsepowerMetric,
+		"HP":                 UnitHorsepowerMechanical,
+		"caballos de fuerza": UnitHorsepowerMechanical,
+		"BTU/hora":           UnitBritishThermalUnitsPerHour,
+	},
+	Pressure: map[string]UnitPressure{
+		"pascales":                    UnitPascals,
+		"hectopascales":               UnitHectoPascals,
+		"kilopascales":                UnitKiloPascals,
+		"megapascales":                UnitMegaPascals,
+		"milibares":                   UnitMilliBars,
+		"bares":                       UnitBars,
+		"atmósferas":                  UnitAtmospheres,
+		"libras por pulgada cuadrada": UnitPoundsPerSquareInch,
+		"milímetros de mercurio":      UnitMillimetersOfMercury,
+		"pulgadas de mercurio":        UnitInchesOfMercury,
+	},
+	Speed: map[string]UnitSpeed{
+		"metros por segundo":  UnitMetersPerSecond,
+		"kilómetros por hora": UnitKiloMetersPerHour,
+		"millas por hora":     UnitMilesPerHour,
+		"nudo":                UnitKnots,
+		"nudos":               UnitKnots,
+		"pies por segundo":    UnitFeetPerSecond,
+	},
+	Density: map[string]UnitDensity{
+		"kilogramos por metro cúbico":  UnitKiloGramsPerCubicMeter,
+		"gramos por centímetro cúbico": UnitGramsPerCubicCentiMeter,
+		"gramos por mililitro":         UnitGramsPerMilliLiter,
+		"kilogramos por litro":         UnitKiloGramsPerLiter,
+		"gramos por litro":             UnitGramsPerLiter,
+		"libras por galón":             UnitPoundsPerGallon,
+		"libras por pie cúbico":        UnitPoundsPerCubicFoot,
+	},
+	FlowRate: map[string]UnitFlowRate{
+		"mililitros por minuto":         UnitMilliLitersPerMinute,
+		"litros por segundo":            UnitLitersPerSecond,
+		"litros por minuto":             UnitLitersPerMinute,
+		"litros por hora":               UnitLitersPerHour,
+		"metros cúbicos por segundo":    UnitCubicMetersPerSecond,
+		"metros cúbicos por hora":       UnitCubicMetersPerHour,
+		"galones por minuto":            UnitGallonsPerMinute,
+		"galones imperiales por minuto": UnitImperialGallonsPerMinute,
+		"pies cúbicos por minuto":       UnitCubicFeetPerMinute,
+	},
+	Concentration: map[string]UnitConcentration{
+		"microgramos por litro":     UnitMicrogramsPerLiter,
+		"miligramos por litro":      UnitMilligramsPerLiter,
+		"miligramos por mililitro":  UnitMilligramsPerMilliLiter,
+		"gramos por 100 mililitros": UnitGramsPer100MilliLiters,
+		"% p/v":                     UnitPercentMassPerVolume,
+		"mililitros por litro":      UnitMilliLitersPerLiter,
+		"% vol":                     UnitPercentVolumePerVolume,
+		"miligramos por kilogramo":  UnitMilligramsPerKilogram,
+		"gramos por kilogramo":      UnitGramsPerKilogram,
+		"gramos por 100 gramos":     UnitGramsPer100Grams,
+		"por ciento":                UnitPercent,
+		"% p/p":                     UnitPercent,
+		"partes por millón":         UnitPartsPerMillion,
+		"partes por mil millones":   UnitPartsPerBillion,
+	},
+}
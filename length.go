@@ -0,0 +1,158 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidLengthAmount = errors.New("invalid length amount")
+	ErrInvalidLengthUnit   = errors.New("invalid length unit")
+)
+
+type Length struct {
+	Amount float64    `json:"amount"`
+	Unit   UnitLength `json:"unit"`
+}
+
+func NewLengthFromString(s string) (*Length, error) {
+	s = normalizeUnicode(s)
+
+	var unit UnitLength
+	var maxl int
+	for _, u := range UnitLengthAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitLengthUnknown {
+		return nil, ErrInvalidLengthUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidLengthAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Length{Amount: amount, Unit: unit}, nil
+}
+
+func (s Length) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
+
+func (s *Length) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+// Convert is exact for whole factors, metric and imperial units are bridged by 1 in = 25.4 mm.
+func (s Length) Convert(unit UnitLength) Length {
+	if v, ok := TryConvertExactLength(s.Amount, s.Unit, unit); ok {
+		return Length{Amount: v, Unit: unit}
+	}
+	f, _ := exactFactor(s.Unit, unit, unitLengthLadders[:], unitLengthBridges[:])
+	return Length{Amount: s.Amount * float64(f.Num) / float64(f.Den), Unit: unit}
+}
+
+// Add sums lengths in unit of s, or in finer unit when both are in same ladder, so 1m + 5cm is exactly 105cm.
+func (s Length) Add(o Length) Length {
+	unit := s.Unit
+	for _, l := range unitLengthLadders {
+		if u, ok := l.finer(s.Unit, o.Unit); ok {
+			unit = u
+			break
+		}
+	}
+	return Length{Amount: s.Convert(unit).Amount + o.Convert(unit).Amount, Unit: unit}
+}
+
+// Sub subtracts lengths in same unit as Add.
+func (s Length) Sub(o Length) Length { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number, e.g. count of items.
+func (s Length) Scale(k float64) Length { return Length{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 1m / 25cm is 4.
+func (s Length) Div(o Length) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
+// TryConvertExactLength converts along ladders and across metric to imperial bridge, e.g. 5in is exactly 127mm.
+func TryConvertExactLength[T int32 | int64 | float32 | float64](amount T, from, to UnitLength) (v T, ok bool) {
+	for _, l := range unitLengthLadders {
+		if v, ok := convertByLadder(amount, from, to, l); ok {
+			return v, true
+		}
+	}
+	f, ok := exactFactor(from, to, unitLengthLadders[:], unitLengthBridges[:])
+	if !ok {
+		return 0, false
+	}
+	return convertByRational(amount, f)
+}
+
+type UnitLength uint8
+
+//go:generate go-enum-encoding -type=UnitLength -string
+const (
+	UnitLengthUnknown UnitLength = iota // json:""
+	UnitMilliMeters                     // json:"mm"
+	UnitCentiMeters                     // json:"cm"
+	UnitDeciMeters                      // json:"dm"
+	UnitMeters                          // json:"m"
+	UnitKiloMeters                      // json:"km"
+	UnitInches                          // json:"in"
+	UnitFeet                            // json:"ft"
+	UnitYards                           // json:"yd"
+	UnitMiles                           // json:"mi"
+	UnitNauticalMiles                   // json:"nmi"
+)
+
+var UnitLengthAll = [...]UnitLength{
+	UnitMilliMeters,
+	UnitCentiMeters,
+	UnitDeciMeters,
+	UnitMeters,
+	UnitKiloMeters,
+	UnitInches,
+	UnitFeet,
+	UnitYards,
+	UnitMiles,
+	UnitNauticalMiles,
+}
+
+var unitLengthMeterLadder = ladder[UnitLength]{
+	{UnitMilliMeters, 1},
+	{UnitCentiMeters, 10},
+	{UnitDeciMeters, 10},
+	{UnitMeters, 10},
+	{UnitKiloMeters, 1000},
+}
+
+var unitLengthInchLadder = ladder[UnitLength]{
+	{UnitInches, 1},
+	{UnitFeet, 12},
+	{UnitYards, 3},
+	{UnitMiles, 1760},
+}
+
+var unitLengthLadders = [...]ladder[UnitLength]{
+	unitLengthMeterLadder,
+	unitLengthInchLadder,
+}
+
+// international inch is defined as exactly 25.4 mm, international nautical mile as exactly 1852 m.
+var unitLengthBridges = [...]bridge[UnitLength]{
+	{from: UnitInches, to: UnitMilliMeters, factor: Rational{127, 5}},
+	{from: UnitNauticalMiles, to: UnitMeters, factor: Rational{1852, 1}},
+}
@@ -0,0 +1,135 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleNewLengthFromString() {
+	v, _ := NewLengthFromString("12in")
+	fmt.Println(v.Amount, v.Unit, v.Convert(UnitCentiMeters))
+	// Output: 12 in 30.48cm
+}
+
+func TestLength(t *testing.T) {
+	tests := map[string]Length{
+		"1m":     {Amount: 1, Unit: UnitMeters},
+		"1mm":    {Amount: 1, Unit: UnitMilliMeters},
+		"0.5mi":  {Amount: 0.5, Unit: UnitMiles},
+		"12.5in": {Amount: 12.5, Unit: UnitInches},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewLengthFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewLengthFromString("5"); !errors.Is(err, ErrInvalidLengthUnit) {
+			t.Error(err)
+		}
+		if _, err := NewLengthFromString("m"); !errors.Is(err, ErrInvalidLengthAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Length{Amount: 2, Unit: UnitFeet})
+		if err != nil || string(b) != `{"amount":2,"unit":"ft"}` {
+			t.Error(string(b), err)
+		}
+		var v Length
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"yd"}`), &v); err != nil || v != (Length{3, UnitYards}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestLengthConversion_Exact(t *testing.T) {
+	tests := [][2]Length{
+		{{0, UnitMeters}, {0, UnitMiles}},
+		{{1, UnitMeters}, {1000, UnitMilliMeters}},
+		{{1, UnitKiloMeters}, {100000, UnitCentiMeters}},
+		{{1, UnitMiles}, {63360, UnitInches}},
+		{{1, UnitYards}, {3, UnitFeet}},
+		{{5, UnitInches}, {127, UnitMilliMeters}},
+		{{1, UnitFeet}, {304.8, UnitMilliMeters}},
+		{{1, UnitMiles}, {1609344, UnitMilliMeters}},
+		{{1, UnitNauticalMiles}, {1852, UnitMeters}},
+		{{127, UnitNauticalMiles}, {9260000, UnitInches}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); c != b {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-12 || c.Unit != a.Unit {
+			t.Error(c, a)
+		}
+	}
+}
+
+func TestTryConvertExactLength(t *testing.T) {
+	if v, ok := TryConvertExactLength[int64](5, UnitInches, UnitMilliMeters); !ok || v != 127 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactLength[int64](1, UnitInches, UnitMilliMeters); ok {
+		t.Error("25.4mm is not whole", v)
+	}
+	if v, ok := TryConvertExactLength[int64](1609344, UnitMilliMeters, UnitMiles); !ok || v != 1 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactLength[int32](2000, UnitMiles, UnitMilliMeters); ok {
+		t.Error("overflow", v)
+	}
+	if v, ok := TryConvertExactLength(math.MaxFloat64, UnitMiles, UnitMilliMeters); ok || v != 0 {
+		t.Error("overflow", v)
+	}
+	if v, ok := TryConvertExactLength[float32](math.MaxFloat32, UnitMiles, UnitMilliMeters); ok || v != 0 {
+		t.Error("overflow", v)
+	}
+	if v, ok := TryConvertExactLength(1.5, UnitMeters, UnitCentiMeters); !ok || v != 150 {
+		t.Error(v, ok)
+	}
+}
+
+func TestLength_Add(t *testing.T) {
+	if v := (Length{1, UnitMeters}).Add(Length{5, UnitCentiMeters}); v != (Length{105, UnitCentiMeters}) {
+		t.Error(v)
+	}
+	if v := (Length{1, UnitFeet}).Add(Length{6, UnitInches}); v != (Length{18, UnitInches}) {
+		t.Error(v)
+	}
+	if v := (Length{1, UnitMeters}).Sub(Length{1, UnitInches}); v != (Length{0.9746, UnitMeters}) {
+		t.Error(v)
+	}
+}
+
+func TestParser_ParseLength(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Length{
+		"5 feet":     {5, UnitFeet},
+		"12\"":       {12, UnitInches},
+		"2,5 м":      {2.5, UnitMeters},
+		"10 CM":      {10, UnitCentiMeters},
+		"3 metres":   {3, UnitMeters},
+		"1 ½ inches": {1.5, UnitInches},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseLength(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
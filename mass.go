@@ -17,6 +17,8 @@ type Mass struct {
 }
 
 func NewMassFromString(s string) (*Mass, error) {
+	s = normalizeUnicode(s)
+
 	var unit UnitMass
 	var maxl int
 	for _, u := range UnitMassAll {
@@ -30,12 +32,12 @@ func NewMassFromString(s string) (*Mass, error) {
 		return nil, ErrInvalidMassUnit
 	}
 
-	lenAmount := len(s) - len(unit.String())
-	if lenAmount <= 0 {
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
 		return nil, ErrInvalidMassAmount
 	}
 
-	amount, err := strconv.ParseFloat(s[:lenAmount], 64)
+	amount, err := strconv.ParseFloat(a, 64)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +47,10 @@ func NewMassFromString(s string) (*Mass, error) {
 
 func (s Mass) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
 
+func (s Mass) StringStyle(style SymbolStyle) string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.Symbol(style)
+}
+
 func (s *Mass) IsZero() bool {
 	if s == nil {
 		return true
@@ -62,6 +68,24 @@ func (s Mass) Convert(unit UnitMass) Mass {
 	return Mass{Amount: convertMassApproxFromGram(convertMassApproxToGram(s.Amount, s.Unit), unit), Unit: unit}
 }
 
+// Add sums masses in unit of s, or in finer unit when both are in same ladder, so 2kg + 500g is exactly 2500g.
+func (s Mass) Add(o Mass) Mass {
+	unit := s.Unit
+	if u, ok := unitMassLadder.finer(s.Unit, o.Unit); ok {
+		unit = u
+	}
+	return Mass{Amount: s.Convert(unit).Amount + o.Convert(unit).Amount, Unit: unit}
+}
+
+// Sub subtracts masses in same unit as Add.
+func (s Mass) Sub(o Mass) Mass { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number, e.g. count of items.
+func (s Mass) Scale(k float64) Mass { return Mass{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 1kg / 250g is 4.
+func (s Mass) Div(o Mass) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
 func TryConvertExactMass[T int32 | int64 | float32 | float64](amount T, from, to UnitMass) (v T, ok bool) {
 	return convertByLadder(amount, from, to, unitMassLadder)
 }
@@ -89,6 +113,13 @@ const (
 	UnitSlugs                       // json:"slug"
 )
 
+func (s UnitMass) Symbol(style SymbolStyle) string {
+	if v, ok := unicodeSymbolsMass[s]; ok && style == SymbolStyleUnicode {
+		return v
+	}
+	return s.String()
+}
+
 var UnitMassAll = [...]UnitMass{
 	UnitPicograms,
 	UnitNanograms,
@@ -154,3 +154,29 @@ func TestTryConvertExactMass_CrossSystemFails(t *testing.T) {
 		}
 	}
 }
+
+func TestMass_Add(t *testing.T) {
+	tests := []struct {
+		a, b, sum Mass
+	}{
+		{Mass{2, UnitKilograms}, Mass{500, UnitGrams}, Mass{2500, UnitGrams}},
+		{Mass{500, UnitGrams}, Mass{2, UnitKilograms}, Mass{2500, UnitGrams}},
+		{Mass{1, UnitGrams}, Mass{1, UnitGrams}, Mass{2, UnitGrams}},
+		{Mass{1, UnitPounds}, Mass{8, UnitOunces}, Mass{1.5, UnitPounds}},
+	}
+	for _, tt := range tests {
+		if v := tt.a.Add(tt.b); v != tt.sum {
+			t.Error(tt.a, tt.b, v, tt.sum)
+		}
+	}
+
+	if v := (Mass{1, UnitKilograms}).Sub(Mass{1, UnitPounds}); v.Unit != UnitKilograms || math.Abs(v.Amount-0.546408) > 1e-6 {
+		t.Error(v)
+	}
+	if v := (Mass{330, UnitGrams}).Scale(6); v != (Mass{1980, UnitGrams}) {
+		t.Error(v)
+	}
+	if v := (Mass{1, UnitKilograms}).Div(Mass{250, UnitGrams}); v != 4 {
+		t.Error(v)
+	}
+}
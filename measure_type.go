@@ -4,7 +4,34 @@ type MeasureType uint8
 
 //go:generate go-enum-encoding -type=MeasureType -string
 const (
-	MeasureTypeUndefined MeasureType = iota // json:""
-	MeasureTypeMass                         // json:"mass"
-	MeasureTypeVolume                       // json:"volume"
+	MeasureTypeUndefined     MeasureType = iota // json:""
+	MeasureTypeMass                             // json:"mass"
+	MeasureTypeVolume                           // json:"volume"
+	MeasureTypeLength                           // json:"length"
+	MeasureTypeArea                             // json:"area"
+	MeasureTypeTemperature                      // json:"temperature"
+	MeasureTypeTime                             // json:"time"
+	MeasureTypeEnergy                           // json:"energy"
+	MeasureTypePower                            // json:"power"
+	MeasureTypePressure                         // json:"pressure"
+	MeasureTypeSpeed                            // json:"speed"
+	MeasureTypeDensity                          // json:"density"
+	MeasureTypeFlowRate                         // json:"flow_rate"
+	MeasureTypeConcentration                    // json:"concentration"
 )
+
+var MeasureTypeAll = [...]MeasureType{
+	MeasureTypeMass,
+	MeasureTypeVolume,
+	MeasureTypeLength,
+	MeasureTypeArea,
+	MeasureTypeTemperature,
+	MeasureTypeTime,
+	MeasureTypeEnergy,
+	MeasureTypePower,
+	MeasureTypePressure,
+	MeasureTypeSpeed,
+	MeasureTypeDensity,
+	MeasureTypeFlowRate,
+	MeasureTypeConcentration,
+}
@@ -14,13 +14,35 @@ func (s *MeasureType) UnmarshalText(text []byte) error {
 		*s = MeasureTypeMass
 	case "volume":
 		*s = MeasureTypeVolume
+	case "length":
+		*s = MeasureTypeLength
+	case "area":
+		*s = MeasureTypeArea
+	case "temperature":
+		*s = MeasureTypeTemperature
+	case "time":
+		*s = MeasureTypeTime
+	case "energy":
+		*s = MeasureTypeEnergy
+	case "power":
+		*s = MeasureTypePower
+	case "pressure":
+		*s = MeasureTypePressure
+	case "speed":
+		*s = MeasureTypeSpeed
+	case "density":
+		*s = MeasureTypeDensity
+	case "flow_rate":
+		*s = MeasureTypeFlowRate
+	case "concentration":
+		*s = MeasureTypeConcentration
 	default:
 		return ErrUnknownMeasureType
 	}
 	return nil
 }
 
-var seq_bytes_MeasureType = [...][]byte{[]byte(""), []byte("mass"), []byte("volume")}
+var seq_bytes_MeasureType = [...][]byte{[]byte(""), []byte("mass"), []byte("volume"), []byte("length"), []byte("area"), []byte("temperature"), []byte("time"), []byte("energy"), []byte("power"), []byte("pressure"), []byte("speed"), []byte("density"), []byte("flow_rate"), []byte("concentration")}
 
 func (s MeasureType) MarshalText() ([]byte, error) { return s.AppendText(nil) }
 
@@ -32,12 +54,34 @@ func (s MeasureType) AppendText(b []byte) ([]byte, error) {
 		return append(b, seq_bytes_MeasureType[1]...), nil
 	case MeasureTypeVolume:
 		return append(b, seq_bytes_MeasureType[2]...), nil
+	case MeasureTypeLength:
+		return append(b, seq_bytes_MeasureType[3]...), nil
+	case MeasureTypeArea:
+		return append(b, seq_bytes_MeasureType[4]...), nil
+	case MeasureTypeTemperature:
+		return append(b, seq_bytes_MeasureType[5]...), nil
+	case MeasureTypeTime:
+		return append(b, seq_bytes_MeasureType[6]...), nil
+	case MeasureTypeEnergy:
+		return append(b, seq_bytes_MeasureType[7]...), nil
+	case MeasureTypePower:
+		return append(b, seq_bytes_MeasureType[8]...), nil
+	case MeasureTypePressure:
+		return append(b, seq_bytes_MeasureType[9]...), nil
+	case MeasureTypeSpeed:
+		return append(b, seq_bytes_MeasureType[10]...), nil
+	case MeasureTypeDensity:
+		return append(b, seq_bytes_MeasureType[11]...), nil
+	case MeasureTypeFlowRate:
+		return append(b, seq_bytes_MeasureType[12]...), nil
+	case MeasureTypeConcentration:
+		return append(b, seq_bytes_MeasureType[13]...), nil
 	default:
 		return nil, ErrUnknownMeasureType
 	}
 }
 
-var seq_string_MeasureType = [...]string{"", "mass", "volume"}
+var seq_string_MeasureType = [...]string{"", "mass", "volume", "length", "area", "temperature", "time", "energy", "power", "pressure", "speed", "density", "flow_rate", "concentration"}
 
 func (s MeasureType) String() string {
 	switch s {
@@ -47,6 +91,28 @@ func (s MeasureType) String() string {
 		return seq_string_MeasureType[1]
 	case MeasureTypeVolume:
 		return seq_string_MeasureType[2]
+	case MeasureTypeLength:
+		return seq_string_MeasureType[3]
+	case MeasureTypeArea:
+		return seq_string_MeasureType[4]
+	case MeasureTypeTemperature:
+		return seq_string_MeasureType[5]
+	case MeasureTypeTime:
+		return seq_string_MeasureType[6]
+	case MeasureTypeEnergy:
+		return seq_string_MeasureType[7]
+	case MeasureTypePower:
+		return seq_string_MeasureType[8]
+	case MeasureTypePressure:
+		return seq_string_MeasureType[9]
+	case MeasureTypeSpeed:
+		return seq_string_MeasureType[10]
+	case MeasureTypeDensity:
+		return seq_string_MeasureType[11]
+	case MeasureTypeFlowRate:
+		return seq_string_MeasureType[12]
+	case MeasureTypeConcentration:
+		return seq_string_MeasureType[13]
 	default:
 		return ""
 	}
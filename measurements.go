@@ -1,16 +1,236 @@
 package measurement
 
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrInvalidMeasurements = errors.New("invalid measurements")
+
 // Measurements is a container describing single instance in measurement dimensions.
 // Not all dimensions may be present.
 type Measurements struct {
 	Quantity float32 `json:"quantity,omitzero"`
 	Mass     *Mass   `json:"mass,omitzero"`
 	Volume   *Volume `json:"volume,omitzero"`
+	Length   *Length `json:"length,omitzero"`
+	Area     *Area   `json:"area,omitzero"`
+}
+
+// NewMeasurementsFromString parses pack notation as it is written on shelves, with English unit aliases.
+func NewMeasurementsFromString(s string) (*Measurements, error) {
+	return ExtractorParser.ParseMeasurements(s)
+}
+
+// ParseMeasurements parses pack notation: "330ml", "6x330ml", "4 × 125 g", "12-pack 12 fl oz", "pack of 6 330ml", "330ml x 6".
+// Counts of nested packs multiply, so "2x(3x100g)" is 6 items of 100g.
+// Mass and volume of same item may be both given, e.g. "6x330ml 350g", and length and area as String writes them, e.g. "2x350g 5cm".
+func (p Parser) ParseMeasurements(s string) (*Measurements, error) {
+	m := Measurements{Quantity: 1}
+
+	s, pack := normalizeUnicode(s), false
+	for {
+		count, rest, ok := cutPackCount(s)
+		if !ok {
+			break
+		}
+		m.Quantity *= count
+		s, pack = rest, true
+	}
+
+	s = trimParens(s)
+	if s == "" {
+		if !pack {
+			return nil, ErrInvalidMeasurements
+		}
+		return &m, nil
+	}
+
+	if v, err := p.ParseMass(s); err == nil {
+		m.Mass = v
+		return &m, nil
+	}
+	if v, err := p.ParseVolume(s); err == nil {
+		m.Volume = v
+		return &m, nil
+	}
+	if v, err := p.ParseLength(s); err == nil {
+		m.Length = v
+		return &m, nil
+	}
+	if v, err := p.ParseArea(s); err == nil {
+		m.Area = v
+		return &m, nil
+	}
+
+	e := p.Extract(s)
+	if len(e.Matches) == 0 || len(e.Conflicts) != 0 || !coversText(s, e.Matches) {
+		if f, ok := p.parseFields(s, m); ok {
+			return &f, nil
+		}
+		return nil, ErrInvalidMeasurements
+	}
+	m.Mass, m.Volume = e.Measurements.Mass, e.Measurements.Volume
+	for _, q := range e.Matches {
+		m.Quantity *= max(q.Quantity, 1)
+	}
+	return &m, nil
+}
+
+// parseFields parses measures separated by spaces, one of each dimension, as String writes them.
+func (p Parser) parseFields(s string, m Measurements) (Measurements, bool) {
+	for _, f := range strings.Fields(s) {
+		if v, err := p.ParseMass(f); err == nil && m.Mass == nil {
+			m.Mass = v
+		} else if v, err := p.ParseVolume(f); err == nil && m.Volume == nil {
+			m.Volume = v
+		} else if v, err := p.ParseLength(f); err == nil && m.Length == nil {
+			m.Length = v
+		} else if v, err := p.ParseArea(f); err == nil && m.Area == nil {
+			m.Area = v
+		} else {
+			return m, false
+		}
+	}
+	return m, true
+}
+
+// String is pack notation, e.g. "6x330ml", count is omitted for single item.
+func (s Measurements) String() string {
+	var parts []string
+	if s.Mass != nil {
+		parts = append(parts, s.Mass.String())
+	}
+	if s.Volume != nil {
+		parts = append(parts, s.Volume.String())
+	}
+	if s.Length != nil {
+		parts = append(parts, s.Length.String())
+	}
+	if s.Area != nil {
+		parts = append(parts, s.Area.String())
+	}
+	v := strings.Join(parts, " ")
+
+	switch {
+	case s.Quantity == 0 || (s.Quantity == 1 && v != ""):
+		return v
+	case v == "":
+		return strconv.FormatFloat(float64(s.Quantity), 'f', -1, 32)
+	default:
+		return strconv.FormatFloat(float64(s.Quantity), 'f', -1, 32) + "x" + v
+	}
 }
 
 func (s *Measurements) IsZero() bool {
 	if s == nil {
 		return true
 	}
-	return s.Quantity == 0 && s.Mass.IsZero() && s.Volume.IsZero()
+	return s.Quantity == 0 && s.Mass.IsZero() && s.Volume.IsZero() && s.Length.IsZero() && s.Area.IsZero()
+}
+
+// count is number of items, unknown quantity is single item.
+func (s Measurements) count() float64 {
+	if s.Quantity == 0 {
+		return 1
+	}
+	return float64(s.Quantity)
+}
+
+// TotalMass is mass of all items in unit of single item, e.g. 4x125g is 500g.
+func (s Measurements) TotalMass() *Mass {
+	if s.Mass == nil {
+		return nil
+	}
+	return &Mass{Amount: s.Mass.Amount * s.count(), Unit: s.Mass.Unit}
+}
+
+// TotalVolume is volume of all items in unit of single item, e.g. 6x330ml is 1980ml.
+func (s Measurements) TotalVolume() *Volume {
+	if s.Volume == nil {
+		return nil
+	}
+	return &Volume{Amount: s.Volume.Amount * s.count(), Unit: s.Volume.Unit}
+}
+
+var packWords = []string{"-pack", "pack", "-pk", "pk"}
+
+// cutPackCount cuts outer count of pack from "N x Q", "N-pack Q", "pack of N Q" and "Q x N".
+func cutPackCount(s string) (count float32, rest string, ok bool) {
+	s = trimParens(s)
+
+	if len(s) > 8 && strings.EqualFold(s[:8], "pack of ") {
+		n := len(s[8:]) - len(strings.TrimLeft(s[8:], "0123456789"))
+		if count, ok := parseCount(s[8 : 8+n]); ok {
+			return count, strings.TrimSpace(s[8+n:]), true
+		}
+		return 0, "", false
+	}
+
+	if n := len(s) - len(strings.TrimLeft(s, "0123456789")); n > 0 {
+		if count, ok := parseCount(s[:n]); ok {
+			if k := scanTimes(s, n); k != -1 && k < len(s) {
+				return count, s[k:], true
+			}
+
+			rest := strings.TrimLeft(s[n:], " ")
+			for _, w := range packWords {
+				if len(rest) < len(w) || !strings.EqualFold(rest[:len(w)], w) {
+					continue
+				}
+				if next, _ := utf8.DecodeRuneInString(rest[len(w):]); !unicode.IsLetter(next) {
+					return count, strings.TrimSpace(rest[len(w):]), true
+				}
+			}
+		}
+	}
+
+	if n := len(s) - len(strings.TrimRight(s, "0123456789")); n > 0 {
+		if count, ok := parseCount(s[len(s)-n:]); ok {
+			rest := strings.TrimRight(s[:len(s)-n], " ")
+			if r, size := utf8.DecodeLastRuneInString(rest); r == 'x' || r == 'X' || r == '×' || r == '*' {
+				if rest = strings.TrimSpace(rest[:len(rest)-size]); rest != "" {
+					return count, rest, true
+				}
+			}
+		}
+	}
+
+	return 0, "", false
+}
+
+// trimParens removes spaces and parentheses around whole s, "(3x100g)" is "3x100g" but "(a)(b)" is not changed.
+func trimParens(s string) string {
+	s = strings.TrimSpace(s)
+	for len(s) > 1 && s[0] == '(' && s[len(s)-1] == ')' {
+		depth := 0
+		for i, r := range s {
+			switch r {
+			case '(':
+				depth++
+			case ')':
+				depth--
+			}
+			if depth == 0 && i < len(s)-1 {
+				return s
+			}
+		}
+		s = strings.TrimSpace(s[1 : len(s)-1])
+	}
+	return s
+}
+
+// coversText is true when text between matches is only separators, as in "330ml / 350g".
+func coversText(s string, matches []Match) bool {
+	i := 0
+	for _, m := range append(matches, Match{Start: len(s), End: len(s)}) {
+		if strings.Trim(s[i:m.Start], " /,;+") != "" {
+			return false
+		}
+		i = m.End
+	}
+	return true
 }
@@ -0,0 +1,117 @@
+package measurement
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleNewMeasurementsFromString() {
+	m, _ := NewMeasurementsFromString("6 x 330ml")
+	fmt.Println(m, m.TotalVolume().Convert(UnitLiters))
+	// Output: 6x330ml 1.98l
+}
+
+func TestMeasurements(t *testing.T) {
+	mass := func(amount float64, unit UnitMass) *Mass { return &Mass{amount, unit} }
+	volume := func(amount float64, unit UnitVolume) *Volume { return &Volume{amount, unit} }
+	length := func(amount float64, unit UnitLength) *Length { return &Length{amount, unit} }
+	area := func(amount float64, unit UnitArea) *Area { return &Area{amount, unit} }
+
+	tests := map[string]Measurements{
+		"330ml":            {Quantity: 1, Volume: volume(330, UnitMilliLiters)},
+		"6x330ml":          {Quantity: 6, Volume: volume(330, UnitMilliLiters)},
+		"6 X 330 ml":       {Quantity: 6, Volume: volume(330, UnitMilliLiters)},
+		"4 × 125 g":        {Quantity: 4, Mass: mass(125, UnitGrams)},
+		"4*125g":           {Quantity: 4, Mass: mass(125, UnitGrams)},
+		"12-pack 12 fl oz": {Quantity: 12, Volume: volume(12, UnitFluidOunces)},
+		"12 pack 12 fl oz": {Quantity: 12, Volume: volume(12, UnitFluidOunces)},
+		"6pk 330ml":        {Quantity: 6, Volume: volume(330, UnitMilliLiters)},
+		"pack of 6 330ml":  {Quantity: 6, Volume: volume(330, UnitMilliLiters)},
+		"330ml x 6":        {Quantity: 6, Volume: volume(330, UnitMilliLiters)},
+		"1.5 l × 6":        {Quantity: 6, Volume: volume(1.5, UnitLiters)},
+		"2x(3x100g)":       {Quantity: 6, Mass: mass(100, UnitGrams)},
+		"(3x100g) x 2":     {Quantity: 6, Mass: mass(100, UnitGrams)},
+		"2 x 3 x 100g":     {Quantity: 6, Mass: mass(100, UnitGrams)},
+		"(100g)":           {Quantity: 1, Mass: mass(100, UnitGrams)},
+		"12-pack":          {Quantity: 12},
+		"6x330ml 350g":     {Quantity: 6, Mass: mass(350, UnitGrams), Volume: volume(330, UnitMilliLiters)},
+		"6x(330ml / 350g)": {Quantity: 6, Mass: mass(350, UnitGrams), Volume: volume(330, UnitMilliLiters)},
+		"６×３３０ｍｌ":          {Quantity: 6, Volume: volume(330, UnitMilliLiters)},
+		"1,000 g":          {Quantity: 1, Mass: mass(1000, UnitGrams)},
+		"2 x 16.9 FL. OZ.": {Quantity: 2, Volume: volume(16.9, UnitFluidOunces)},
+		"24 x 1 1/2 cups":  {Quantity: 24, Volume: volume(1.5, UnitCups)},
+		"10 x 1kg":         {Quantity: 10, Mass: mass(1, UnitKilograms)},
+		"2x(3x(2x10mg))":   {Quantity: 12, Mass: mass(10, UnitMilligrams)},
+		"pack of 2 (6x5g)": {Quantity: 12, Mass: mass(5, UnitGrams)},
+		"1 x 500ml":        {Quantity: 1, Volume: volume(500, UnitMilliLiters)},
+		"2x5cm":            {Quantity: 2, Length: length(5, UnitCentiMeters)},
+		"2x350g 5cm":       {Quantity: 2, Mass: mass(350, UnitGrams), Length: length(5, UnitCentiMeters)},
+		"1.5m2":            {Quantity: 1, Area: area(1.5, UnitSquareMeters)},
+		"4x20cm 300cm2":    {Quantity: 4, Length: length(20, UnitCentiMeters), Area: area(300, UnitSquareCentiMeters)},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			m, err := NewMeasurementsFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.Quantity != v.Quantity || (m.Mass == nil) != (v.Mass == nil) || (m.Volume == nil) != (v.Volume == nil) || (m.Length == nil) != (v.Length == nil) || (m.Area == nil) != (v.Area == nil) {
+				t.Fatal(m, v)
+			}
+			if (m.Mass != nil && *m.Mass != *v.Mass) || (m.Volume != nil && *m.Volume != *v.Volume) || (m.Length != nil && *m.Length != *v.Length) || (m.Area != nil && *m.Area != *v.Area) {
+				t.Error(m, v)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		for _, s := range []string{"", "6x", "x330ml", "0x330ml", "6x330", "pack of x 330ml", "330ml 1l", "5cm 2m", "330ml and 350g", "(6x330ml"} {
+			if _, err := NewMeasurementsFromString(s); !errors.Is(err, ErrInvalidMeasurements) {
+				t.Error(s, err)
+			}
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		tests := map[string]Measurements{
+			"6x330ml":      {Quantity: 6, Volume: volume(330, UnitMilliLiters)},
+			"330ml":        {Quantity: 1, Volume: volume(330, UnitMilliLiters)},
+			"100g":         {Quantity: 0, Mass: mass(100, UnitGrams)},
+			"6x350g 330ml": {Quantity: 6, Mass: mass(350, UnitGrams), Volume: volume(330, UnitMilliLiters)},
+			"12":           {Quantity: 12},
+			"2x5cm":        {Quantity: 2, Length: length(5, UnitCentiMeters)},
+			"2x350g 5cm":   {Quantity: 2, Mass: mass(350, UnitGrams), Length: length(5, UnitCentiMeters)},
+			"1.5m2":        {Area: area(1.5, UnitSquareMeters)},
+			"3x1m2":        {Quantity: 3, Area: area(1, UnitSquareMeters)},
+			"":             {},
+		}
+		for s, m := range tests {
+			if got := m.String(); got != s {
+				t.Error(got, s)
+			}
+		}
+	})
+
+	t.Run("when string parsed, then same", func(t *testing.T) {
+		for _, s := range []string{"6x330ml", "330ml", "6x350g 330ml", "2x5cm", "2x350g 5cm", "1.5m2", "3x1m2", "4x20cm 300cm2"} {
+			m, err := NewMeasurementsFromString(s)
+			if err != nil {
+				t.Fatal(s, err)
+			}
+			if m.String() != s {
+				t.Error(m, s)
+			}
+		}
+	})
+
+	t.Run("total", func(t *testing.T) {
+		m := Measurements{Quantity: 4, Mass: mass(125, UnitGrams), Volume: volume(330, UnitMilliLiters)}
+		if *m.TotalMass() != (Mass{500, UnitGrams}) || *m.TotalVolume() != (Volume{1320, UnitMilliLiters}) {
+			t.Error(m.TotalMass(), m.TotalVolume())
+		}
+		if m := (Measurements{Mass: mass(1, UnitKilograms)}); *m.TotalMass() != (Mass{1, UnitKilograms}) || m.TotalVolume() != nil {
+			t.Error(m.TotalMass(), m.TotalVolume())
+		}
+	})
+}
@@ -0,0 +1,476 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrAmbiguousUnit   = errors.New("ambiguous unit")
+	ErrAmbiguousAmount = errors.New("ambiguous amount")
+)
+
+// Parser parses measurements written by humans.
+// Zero value accepts canonical unit symbols only, in ASCII or Unicode (µg, m³, ㎖, fullwidth digits).
+type Parser struct {
+	Languages []Language
+
+	// Lenient ignores case, spaces and dots in unit names, so "1.5KG" and "16 fl. oz." are accepted.
+	// Spellings that differ only in case (ml and Ml) are ambiguous and not guessed, see Ambiguities.
+	Lenient bool
+
+	// Grouping accepts thousands separators in amounts, e.g. "1,000 g" or "1 000,5 кг".
+	// Amount before canonical symbol that languages read differently is ambiguous, e.g. "1,000 kg" with Russian and English.
+	Grouping bool
+}
+
+func (p Parser) ParseMass(s string) (*Mass, error) {
+	amount, unit, err := parseQuantity(p, s, p.massSymbols(), ErrInvalidMassUnit, ErrInvalidMassAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Mass{Amount: amount, Unit: unit}, nil
+}
+
+func (p Parser) ParseVolume(s string) (*Volume, error) {
+	amount, unit, err := parseQuantity(p, s, p.volumeSymbols(), ErrInvalidVolumeUnit, ErrInvalidVolumeAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Volume{Amount: amount, Unit: unit}, nil
+}
+
+func (p Parser) ParseLength(s string) (*Length, error) {
+	amount, unit, err := parseQuantity(p, s, p.lengthSymbols(), ErrInvalidLengthUnit, ErrInvalidLengthAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Length{Amount: amount, Unit: unit}, nil
+}
+
+func (p Parser) ParseArea(s string) (*Area, error) {
+	amount, unit, err := parseQuantity(p, s, p.areaSymbols(), ErrInvalidAreaUnit, ErrInvalidAreaAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Area{Amount: amount, Unit: unit}, nil
+}
+
+// ParseTemperature accepts degree sign before unit and rejects temperatures below absolute zero.
+func (p Parser) ParseTemperature(s string) (*Temperature, error) {
+	amount, unit, err := parseQuantity(p, trimDegree(s), p.temperatureSymbols(), ErrInvalidTemperatureUnit, ErrInvalidTemperatureAmount)
+	if err != nil {
+		return nil, err
+	}
+	return newTemperature(amount, unit)
+}
+
+func (p Parser) ParseTime(s string) (*Time, error) {
+	amount, unit, err := parseQuantity(p, s, p.timeSymbols(), ErrInvalidTimeUnit, ErrInvalidTimeAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Time{Amount: amount, Unit: unit}, nil
+}
+
+func (p Parser) ParseEnergy(s string) (*Energy, error) {
+	amount, unit, err := parseQuantity(p, s, p.energySymbols(), ErrInvalidEnergyUnit, ErrInvalidEnergyAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Energy{Amount: amount, Unit: unit}, nil
+}
+
+func (p Parser) ParsePower(s string) (*Power, error) {
+	amount, unit, err := parseQuantity(p, s, p.powerSymbols(), ErrInvalidPowerUnit, ErrInvalidPowerAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Power{Amount: amount, Unit: unit}, nil
+}
+
+// ParsePressure accepts gauge and absolute marks after unit, as NewPressureFromString does.
+func (p Parser) ParsePressure(s string) (*Pressure, error) {
+	s, gauge := cutGauge(s)
+	amount, unit, err := parseQuantity(p, s, p.pressureSymbols(), ErrInvalidPressureUnit, ErrInvalidPressureAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Pressure{Amount: amount, Unit: unit, Gauge: gauge}, nil
+}
+
+func (p Parser) ParseSpeed(s string) (*Speed, error) {
+	amount, unit, err := parseQuantity(p, s, p.speedSymbols(), ErrInvalidSpeedUnit, ErrInvalidSpeedAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Speed{Amount: amount, Unit: unit}, nil
+}
+
+func (p Parser) ParseDensity(s string) (*Density, error) {
+	amount, unit, err := parseQuantity(p, s, p.densitySymbols(), ErrInvalidDensityUnit, ErrInvalidDensityAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Density{Amount: amount, Unit: unit}, nil
+}
+
+func (p Parser) ParseFlowRate(s string) (*FlowRate, error) {
+	amount, unit, err := parseQuantity(p, s, p.flowRateSymbols(), ErrInvalidFlowRateUnit, ErrInvalidFlowRateAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &FlowRate{Amount: amount, Unit: unit}, nil
+}
+
+func (p Parser) ParseConcentration(s string) (*Concentration, error) {
+	amount, unit, err := parseQuantity(p, s, p.concentrationSymbols(), ErrInvalidConcentrationUnit, ErrInvalidConcentrationAmount)
+	if err != nil {
+		return nil, err
+	}
+	return &Concentration{Amount: amount, Unit: unit}, nil
+}
+
+// Ambiguity is unit spelling that matches several units in lenient mode.
+type Ambiguity struct {
+	Symbol string
+	Mass   []UnitMass
+	Volume []UnitVolume
+	Length []UnitLength
+	Area   []UnitArea
+
+	Temperature   []UnitTemperature
+	Time          []UnitTime
+	Energy        []UnitEnergy
+	Power         []UnitPower
+	Pressure      []UnitPressure
+	Speed         []UnitSpeed
+	Density       []UnitDensity
+	FlowRate      []UnitFlowRate
+	Concentration []UnitConcentration
+}
+
+// Ambiguities reports spellings that lenient mode can not resolve.
+// Exact spelling always wins, so "5 Ml" is megaliters, but "5 ML" is error.
+func (p Parser) Ambiguities() []Ambiguity {
+	var keys []string
+	seen := make(map[string]bool)
+	mass := foldedUnits(p.massSymbols(), &keys, seen)
+	volume := foldedUnits(p.volumeSymbols(), &keys, seen)
+	length := foldedUnits(p.lengthSymbols(), &keys, seen)
+	area := foldedUnits(p.areaSymbols(), &keys, seen)
+	temperature := foldedUnits(p.temperatureSymbols(), &keys, seen)
+	times := foldedUnits(p.timeSymbols(), &keys, seen)
+	energy := foldedUnits(p.energySymbols(), &keys, seen)
+	power := foldedUnits(p.powerSymbols(), &keys, seen)
+	pressure := foldedUnits(p.pressureSymbols(), &keys, seen)
+	speed := foldedUnits(p.speedSymbols(), &keys, seen)
+	density := foldedUnits(p.densitySymbols(), &keys, seen)
+	flowRate := foldedUnits(p.flowRateSymbols(), &keys, seen)
+	concentration := foldedUnits(p.concentrationSymbols(), &keys, seen)
+
+	var ambiguities []Ambiguity
+	for _, k := range keys {
+		if len(mass[k]) > 1 || len(volume[k]) > 1 || len(length[k]) > 1 || len(area[k]) > 1 || len(temperature[k]) > 1 || len(times[k]) > 1 || len(energy[k]) > 1 || len(power[k]) > 1 || len(pressure[k]) > 1 || len(speed[k]) > 1 || len(density[k]) > 1 || len(flowRate[k]) > 1 || len(concentration[k]) > 1 {
+			ambiguities = append(ambiguities, Ambiguity{
+				Symbol:        k,
+				Mass:          mass[k],
+				Volume:        volume[k],
+				Length:        length[k],
+				Area:          area[k],
+				Temperature:   temperature[k],
+				Time:          times[k],
+				Energy:        energy[k],
+				Power:         power[k],
+				Pressure:      pressure[k],
+				Speed:         speed[k],
+				Density:       density[k],
+				FlowRate:      flowRate[k],
+				Concentration: concentration[k],
+			})
+		}
+	}
+	return ambiguities
+}
+
+// foldedUnits groups units by folded spelling, keys are collected in order of first appearance.
+func foldedUnits[U comparable](symbols []unitSymbol[U], keys *[]string, seen map[string]bool) map[string][]U {
+	units := make(map[string][]U)
+	for _, q := range symbols {
+		k := foldSymbol(q.symbol)
+		if !seen[k] {
+			seen[k] = true
+			*keys = append(*keys, k)
+		}
+		if !containsUnit(units[k], q.unit) {
+			units[k] = append(units[k], q.unit)
+		}
+	}
+	return units
+}
+
+func containsUnit[U comparable](units []U, unit U) bool {
+	for _, u := range units {
+		if u == unit {
+			return true
+		}
+	}
+	return false
+}
+
+// numberFormat is how amount is written in some language.
+type numberFormat struct {
+	decimal rune
+	group   rune
+}
+
+var numberFormatCanonical = numberFormat{decimal: '.', group: ','}
+
+// unitSymbol is single spelling of unit with number formats allowed in amount before it.
+// Shared symbol is used by all languages, so none of their formats is preferred.
+type unitSymbol[U comparable] struct {
+	symbol  string
+	unit    U
+	formats []numberFormat
+	shared  bool
+}
+
+// canonical symbols are shared by all languages, so amount may use any of their number formats.
+func (p Parser) formats() []numberFormat {
+	formats := []numberFormat{numberFormatCanonical}
+	for _, l := range p.Languages {
+		formats = append(formats, l.numberFormat())
+	}
+	return formats
+}
+
+func (p Parser) massSymbols() []unitSymbol[UnitMass] {
+	return unitSymbols(p, UnitMassAll[:], func(l Language) map[string]UnitMass { return l.Mass })
+}
+
+func (p Parser) volumeSymbols() []unitSymbol[UnitVolume] {
+	return unitSymbols(p, UnitVolumeAll[:], func(l Language) map[string]UnitVolume { return l.Volume })
+}
+
+func (p Parser) lengthSymbols() []unitSymbol[UnitLength] {
+	return unitSymbols(p, UnitLengthAll[:], func(l Language) map[string]UnitLength { return l.Length })
+}
+
+func (p Parser) areaSymbols() []unitSymbol[UnitArea] {
+	return unitSymbols(p, UnitAreaAll[:], func(l Language) map[string]UnitArea { return l.Area })
+}
+
+func (p Parser) temperatureSymbols() []unitSymbol[UnitTemperature] {
+	return unitSymbols(p, UnitTemperatureAll[:], func(l Language) map[string]UnitTemperature { return l.Temperature })
+}
+
+func (p Parser) timeSymbols() []unitSymbol[UnitTime] {
+	symbols := unitSymbols(p, UnitTimeAll[:], func(l Language) map[string]UnitTime { return l.Time })
+	return append(symbols, unitSymbol[UnitTime]{symbol: normalizedMicroSeconds, unit: UnitMicroSeconds, formats: p.formats(), shared: true})
+}
+
+func (p Parser) energySymbols() []unitSymbol[UnitEnergy] {
+	return unitSymbols(p, UnitEnergyAll[:], func(l Language) map[string]UnitEnergy { return l.Energy })
+}
+
+func (p Parser) powerSymbols() []unitSymbol[UnitPower] {
+	return unitSymbols(p, UnitPowerAll[:], func(l Language) map[string]UnitPower { return l.Power })
+}
+
+func (p Parser) pressureSymbols() []unitSymbol[UnitPressure] {
+	return unitSymbols(p, UnitPressureAll[:], func(l Language) map[string]UnitPressure { return l.Pressure })
+}
+
+func (p Parser) speedSymbols() []unitSymbol[UnitSpeed] {
+	return unitSymbols(p, UnitSpeedAll[:], func(l Language) map[string]UnitSpeed { return l.Speed })
+}
+
+func (p Parser) densitySymbols() []unitSymbol[UnitDensity] {
+	return unitSymbols(p, UnitDensityAll[:], func(l Language) map[string]UnitDensity { return l.Density })
+}
+
+func (p Parser) flowRateSymbols() []unitSymbol[UnitFlowRate] {
+	symbols := unitSymbols(p, UnitFlowRateAll[:], func(l Language) map[string]UnitFlowRate { return l.FlowRate })
+	for q, u := range shortSymbolsFlowRate {
+		symbols = append(symbols, unitSymbol[UnitFlowRate]{symbol: q, unit: u, formats: p.formats(), shared: true})
+	}
+	return symbols
+}
+
+func (p Parser) concentrationSymbols() []unitSymbol[UnitConcentration] {
+	return unitSymbols(p, UnitConcentrationAll[:], func(l Language) map[string]UnitConcentration { return l.Concentration })
+}
+
+// unitSymbols are canonical symbols of units followed by their names in languages of parser.
+func unitSymbols[U interface {
+	comparable
+	String() string
+}](p Parser, all []U, names func(Language) map[string]U) []unitSymbol[U] {
+	formats := p.formats()
+	symbols := make([]unitSymbol[U], 0, len(all))
+	for _, u := range all {
+		symbols = append(symbols, unitSymbol[U]{symbol: u.String(), unit: u, formats: formats, shared: true})
+	}
+	for _, l := range p.Languages {
+		for name, u := range names(l) {
+			symbols = append(symbols, unitSymbol[U]{symbol: normalizeUnicode(name), unit: u, formats: []numberFormat{l.numberFormat(), numberFormatCanonical}})
+		}
+	}
+	return symbols
+}
+
+// parseQuantity splits s into amount and unit, finds unit spelling and parses amount in its number formats.
+func parseQuantity[U comparable](p Parser, s string, symbols []unitSymbol[U], errUnit, errAmount error) (amount float64, unit U, err error) {
+	a, u := splitAmount(strings.TrimSpace(normalizeUnicode(s)))
+	matches, err := matchUnit(p, u, symbols, errUnit)
+	if err != nil {
+		return 0, unit, err
+	}
+
+	if a == "" {
+		return 0, unit, errAmount
+	}
+
+	for _, q := range matches {
+		v, ok := parseAmount(a, q.formats, p.Grouping)
+		if !ok {
+			continue
+		}
+		if q.shared && isAmbiguous(a, v, q.formats) {
+			return 0, unit, ErrAmbiguousAmount
+		}
+		return v, q.unit, nil
+	}
+	return 0, unit, errAmount
+}
+
+// matchUnit finds spellings of unit u, all of them are of same unit.
+func matchUnit[U comparable](p Parser, u string, symbols []unitSymbol[U], errUnit error) ([]unitSymbol[U], error) {
+	if u == "" {
+		return nil, errUnit
+	}
+
+	// same spelling may come from several languages, each with own number format
+	var matches []unitSymbol[U]
+	for _, q := range symbols {
+		if q.symbol == u {
+			matches = append(matches, q)
+		}
+	}
+
+	if len(matches) == 0 && p.Lenient {
+		k := foldSymbol(u)
+		for _, q := range symbols {
+			if foldSymbol(q.symbol) == k {
+				matches = append(matches, q)
+			}
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, errUnit
+	}
+
+	for _, q := range matches[1:] {
+		if q.unit != matches[0].unit {
+			return nil, ErrAmbiguousUnit
+		}
+	}
+	return matches, nil
+}
+
+// isAmbiguous is true when some format reads amount as other value than v, as "1,000" is 1000 or 1.
+// Formats are read with groups even when parser does not accept them, so meaning does not depend on Grouping.
+func isAmbiguous(s string, v float64, formats []numberFormat) bool {
+	for _, f := range formats {
+		if w, ok := parseAmount(s, []numberFormat{f}, true); ok && w != v {
+			return true
+		}
+	}
+	return false
+}
+
+func isAmountRune(r rune) bool {
+	return unicode.IsDigit(r) || unicode.IsSpace(r) || isFractionRune(r) || strings.ContainsRune(".,'_+-", r)
+}
+
+// splitAmount splits s at first rune that can not be part of amount.
+func splitAmount(s string) (amount, unit string) {
+	i := strings.IndexFunc(s, func(r rune) bool { return !isAmountRune(r) })
+	if i == -1 {
+		return strings.TrimSpace(s), ""
+	}
+	return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i:])
+}
+
+// foldSymbol is key of unit spelling that ignores case, spaces and dots.
+func foldSymbol(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsSpace(r) || r == '.' {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func parseAmount(s string, formats []numberFormat, grouping bool) (float64, bool) {
+	if strings.IndexFunc(s, isFractionRune) != -1 {
+		r, err := NewRationalFromString(s)
+		return r.Float64(), err == nil
+	}
+
+	for _, f := range formats {
+		a := s
+		if grouping {
+			var ok bool
+			if a, ok = ungroup(a, f); !ok {
+				continue
+			}
+		}
+		if f.decimal != '.' {
+			a = strings.ReplaceAll(a, string(f.decimal), ".")
+		}
+		if v, err := strconv.ParseFloat(a, 64); err == nil {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+func isGroupRune(r rune, f numberFormat) bool {
+	if unicode.IsSpace(f.group) {
+		return unicode.IsSpace(r)
+	}
+	return r == f.group
+}
+
+// ungroup removes group separators from integer part of amount.
+// Groups after the first must have exactly three digits, so "1,5" is not treated as grouped "15".
+func ungroup(s string, f numberFormat) (string, bool) {
+	integer, fraction := s, ""
+	if i := strings.IndexRune(s, f.decimal); i != -1 {
+		integer, fraction = s[:i], s[i:]
+	}
+
+	if strings.IndexFunc(fraction, func(r rune) bool { return isGroupRune(r, f) }) != -1 {
+		return "", false
+	}
+
+	groups := strings.FieldsFunc(integer, func(r rune) bool { return isGroupRune(r, f) })
+	if len(groups) <= 1 {
+		return strings.TrimSpace(integer) + fraction, true
+	}
+
+	for i, g := range groups {
+		g = strings.TrimLeft(g, "+-")
+		if (i == 0 && (len(g) == 0 || len(g) > 3)) || (i > 0 && len(g) != 3) {
+			return "", false
+		}
+	}
+
+	return strings.Join(groups, "") + fraction, true
+}
@@ -0,0 +1,375 @@
+package measurement
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleParser_ParseMass() {
+	p := Parser{Languages: []Language{LanguageRussian}}
+	m, _ := p.ParseMass("1,5 кг")
+	fmt.Println(m)
+	// Output: 1.5kg
+}
+
+func TestParser_Languages(t *testing.T) {
+	p := Parser{Languages: LanguageAll[:]}
+
+	t.Run("mass", func(t *testing.T) {
+		tests := map[string]Mass{
+			"1,5 кг":     {Amount: 1.5, Unit: UnitKilograms},
+			"200 г":      {Amount: 200, Unit: UnitGrams},
+			"2公斤":        {Amount: 2, Unit: UnitKilograms},
+			"500克":       {Amount: 500, Unit: UnitGrams},
+			"2克拉":        {Amount: 2, Unit: UnitCarats},
+			"100グラム":     {Amount: 100, Unit: UnitGrams},
+			"1.5キログラム":   {Amount: 1.5, Unit: UnitKilograms},
+			"250 gramos": {Amount: 250, Unit: UnitGrams},
+			"1,5 kilos":  {Amount: 1.5, Unit: UnitKilograms},
+			"1,5kg":      {Amount: 1.5, Unit: UnitKilograms},
+			"420g":       {Amount: 420, Unit: UnitGrams},
+		}
+		for s, v := range tests {
+			t.Run(s, func(t *testing.T) {
+				m, err := p.ParseMass(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *m != v {
+					t.Error(*m, v)
+				}
+			})
+		}
+	})
+
+	t.Run("volume", func(t *testing.T) {
+		tests := map[string]Volume{
+			"250 мл":      {Amount: 250, Unit: UnitMilliLiters},
+			"1,5 л":       {Amount: 1.5, Unit: UnitLiters},
+			"2 ст. л.":    {Amount: 2, Unit: UnitTablespoons},
+			"500毫升":       {Amount: 500, Unit: UnitMilliLiters},
+			"2英制品脱":       {Amount: 2, Unit: UnitImperialPints},
+			"1.5リットル":     {Amount: 1.5, Unit: UnitLiters},
+			"1,5 litros":  {Amount: 1.5, Unit: UnitLiters},
+			"2 galones":   {Amount: 2, Unit: UnitGallons},
+			"330ml":       {Amount: 330, Unit: UnitMilliLiters},
+			"3 Мл":        {Amount: 3, Unit: UnitMegaLiters},
+			"1 cucharada": {Amount: 1, Unit: UnitTablespoons},
+		}
+		for s, v := range tests {
+			t.Run(s, func(t *testing.T) {
+				m, err := p.ParseVolume(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *m != v {
+					t.Error(*m, v)
+				}
+			})
+		}
+	})
+
+	t.Run("when language not enabled, then error", func(t *testing.T) {
+		if _, err := (Parser{Languages: []Language{LanguageChinese}}).ParseMass("1,5 кг"); !errors.Is(err, ErrInvalidMassUnit) {
+			t.Error(err)
+		}
+		if _, err := (Parser{}).ParseVolume("1,5 litros"); !errors.Is(err, ErrInvalidVolumeUnit) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("when decimal separator of other language, then error", func(t *testing.T) {
+		if _, err := (Parser{Languages: []Language{LanguageChinese}}).ParseMass("1,5公斤"); !errors.Is(err, ErrInvalidMassAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("when no amount, then error", func(t *testing.T) {
+		if _, err := p.ParseMass("кг"); !errors.Is(err, ErrInvalidMassAmount) {
+			t.Error(err)
+		}
+	})
+}
+
+func TestLanguage_AllUnits(t *testing.T) {
+	for i, l := range LanguageAll {
+		mass := make(map[UnitMass]bool)
+		for _, u := range l.Mass {
+			mass[u] = true
+		}
+		for _, u := range UnitMassAll {
+			if !mass[u] {
+				t.Error(i, u)
+			}
+		}
+
+		volume := make(map[UnitVolume]bool)
+		for _, u := range l.Volume {
+			volume[u] = true
+		}
+		for _, u := range UnitVolumeAll {
+			if !volume[u] {
+				t.Error(i, u)
+			}
+		}
+
+		length := make(map[UnitLength]bool)
+		for _, u := range l.Length {
+			length[u] = true
+		}
+		for _, u := range UnitLengthAll {
+			if !length[u] {
+				t.Error(i, u)
+			}
+		}
+
+		area := make(map[UnitArea]bool)
+		for _, u := range l.Area {
+			area[u] = true
+		}
+		for _, u := range UnitAreaAll {
+			if !area[u] {
+				t.Error(i, u)
+			}
+		}
+
+		temperature := make(map[UnitTemperature]bool)
+		for _, u := range l.Temperature {
+			temperature[u] = true
+		}
+		for _, u := range UnitTemperatureAll {
+			if !temperature[u] {
+				t.Error(i, u)
+			}
+		}
+
+		times := make(map[UnitTime]bool)
+		for _, u := range l.Time {
+			times[u] = true
+		}
+		for _, u := range UnitTimeAll {
+			if !times[u] {
+				t.Error(i, u)
+			}
+		}
+
+		energy := make(map[UnitEnergy]bool)
+		for _, u := range l.Energy {
+			energy[u] = true
+		}
+		for _, u := range UnitEnergyAll {
+			if !energy[u] {
+				t.Error(i, u)
+			}
+		}
+
+		power := make(map[UnitPower]bool)
+		for _, u := range l.Power {
+			power[u] = true
+		}
+		for _, u := range UnitPowerAll {
+			if !power[u] {
+				t.Error(i, u)
+			}
+		}
+
+		pressure := make(map[UnitPressure]bool)
+		for _, u := range l.Pressure {
+			pressure[u] = true
+		}
+		for _, u := range UnitPressureAll {
+			if !pressure[u] {
+				t.Error(i, u)
+			}
+		}
+
+		speed := make(map[UnitSpeed]bool)
+		for _, u := range l.Speed {
+			speed[u] = true
+		}
+		for _, u := range UnitSpeedAll {
+			if !speed[u] {
+				t.Error(i, u)
+			}
+		}
+
+		density := make(map[UnitDensity]bool)
+		for _, u := range l.Density {
+			density[u] = true
+		}
+		for _, u := range UnitDensityAll {
+			if !density[u] {
+				t.Error(i, u)
+			}
+		}
+
+		flowRate := make(map[UnitFlowRate]bool)
+		for _, u := range l.FlowRate {
+			flowRate[u] = true
+		}
+		for _, u := range UnitFlowRateAll {
+			if !flowRate[u] {
+				t.Error(i, u)
+			}
+		}
+
+		concentration := make(map[UnitConcentration]bool)
+		for _, u := range l.Concentration {
+			concentration[u] = true
+		}
+		for _, u := range UnitConcentrationAll {
+			if !concentration[u] {
+				t.Error(i, u)
+			}
+		}
+	}
+}
+
+func TestParser_Lenient(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish}, Lenient: true, Grouping: true}
+
+	t.Run("mass", func(t *testing.T) {
+		tests := map[string]Mass{
+			"1.5 kg":    {Amount: 1.5, Unit: UnitKilograms},
+			"1.5KG":     {Amount: 1.5, Unit: UnitKilograms},
+			" 1.5 Kg ":  {Amount: 1.5, Unit: UnitKilograms},
+			"12 lbs":    {Amount: 12, Unit: UnitPounds},
+			"12 LBS.":   {Amount: 12, Unit: UnitPounds},
+			"12#":       {Amount: 12, Unit: UnitPounds},
+			"1,000 g":   {Amount: 1000, Unit: UnitGrams},
+			"1,000.5g":  {Amount: 1000.5, Unit: UnitGrams},
+			"250 gr":    {Amount: 250, Unit: UnitGrams},
+			"250 Grams": {Amount: 250, Unit: UnitGrams},
+			"1 Troy Oz": {Amount: 1, Unit: UnitOuncesTroy},
+		}
+		for s, v := range tests {
+			t.Run(s, func(t *testing.T) {
+				m, err := p.ParseMass(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *m != v {
+					t.Error(*m, v)
+				}
+			})
+		}
+	})
+
+	t.Run("volume", func(t *testing.T) {
+		tests := map[string]Volume{
+			"3 Gallons":   {Amount: 3, Unit: UnitGallons},
+			"16 fl oz":    {Amount: 16, Unit: UnitFluidOunces},
+			"16 fl. oz.":  {Amount: 16, Unit: UnitFluidOunces},
+			"16 FL OZ":    {Amount: 16, Unit: UnitFluidOunces},
+			"2 ltr":       {Amount: 2, Unit: UnitLiters},
+			"2 L":         {Amount: 2, Unit: UnitLiters},
+			"5 cc":        {Amount: 5, Unit: UnitCubicCentiMeters},
+			"400 mL":      {Amount: 400, Unit: UnitMilliLiters},
+			"5 Ml":        {Amount: 5, Unit: UnitMegaLiters},
+			"1,250,000 l": {Amount: 1_250_000, Unit: UnitLiters},
+		}
+		for s, v := range tests {
+			t.Run(s, func(t *testing.T) {
+				m, err := p.ParseVolume(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *m != v {
+					t.Error(*m, v)
+				}
+			})
+		}
+	})
+
+	t.Run("when spelling differs only in case, then ambiguous", func(t *testing.T) {
+		if _, err := p.ParseVolume("5 ML"); !errors.Is(err, ErrAmbiguousUnit) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("when not lenient, then exact spelling only", func(t *testing.T) {
+		if _, err := (Parser{Languages: []Language{LanguageEnglish}}).ParseMass("1.5KG"); !errors.Is(err, ErrInvalidMassUnit) {
+			t.Error(err)
+		}
+		if _, err := (Parser{Languages: []Language{LanguageEnglish}}).ParseVolume("16 fl. oz."); !errors.Is(err, ErrInvalidVolumeUnit) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("when no grouping, then separators rejected", func(t *testing.T) {
+		if _, err := (Parser{}).ParseMass("1,000g"); !errors.Is(err, ErrInvalidMassAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("when grouping is invalid, then error", func(t *testing.T) {
+		for _, s := range []string{"1,00 g", "1,0000 g", "1.5,000 g"} {
+			if _, err := p.ParseMass(s); !errors.Is(err, ErrInvalidMassAmount) {
+				t.Error(s, err)
+			}
+		}
+	})
+
+	t.Run("when grouping in other language, then its format", func(t *testing.T) {
+		p := Parser{Languages: []Language{LanguageRussian, LanguageSpanish}, Grouping: true}
+		tests := map[string]Mass{
+			"1 000,5 кг":     {Amount: 1000.5, Unit: UnitKilograms},
+			"1,5 кг":         {Amount: 1.5, Unit: UnitKilograms},
+			"1,5 kg":         {Amount: 1.5, Unit: UnitKilograms},
+			"1.000 gramos":   {Amount: 1000, Unit: UnitGrams},
+			"1.000,5 gramos": {Amount: 1000.5, Unit: UnitGrams},
+		}
+		for s, v := range tests {
+			if m, err := p.ParseMass(s); err != nil || *m != v {
+				t.Error(s, m, err)
+			}
+		}
+	})
+}
+
+func TestParser_AmbiguousAmount(t *testing.T) {
+	t.Run("when languages read amount differently, then ambiguous", func(t *testing.T) {
+		tests := map[string]Parser{
+			"1,000 kg": {Languages: LanguageAll[:]},
+			"12,345 g": {Languages: LanguageAll[:]},
+			"1.000 kg": {Languages: LanguageAll[:], Grouping: true},
+		}
+		for s, p := range tests {
+			if m, err := p.ParseMass(s); !errors.Is(err, ErrAmbiguousAmount) {
+				t.Error(s, m, err)
+			}
+		}
+	})
+
+	t.Run("when localized name, then its language format", func(t *testing.T) {
+		p := Parser{Languages: LanguageAll[:], Grouping: true}
+		if m, err := p.ParseMass("1.000 gramos"); err != nil || *m != (Mass{1000, UnitGrams}) {
+			t.Error(m, err)
+		}
+	})
+
+	t.Run("when languages agree, then amount", func(t *testing.T) {
+		p := Parser{Languages: LanguageAll[:]}
+		for s, v := range map[string]Mass{"1,5 kg": {1.5, UnitKilograms}, "1.5 kg": {1.5, UnitKilograms}, "1000 kg": {1000, UnitKilograms}} {
+			if m, err := p.ParseMass(s); err != nil || *m != v {
+				t.Error(s, m, err)
+			}
+		}
+	})
+}
+
+func TestParser_Ambiguities(t *testing.T) {
+	var found bool
+	for _, q := range (Parser{Languages: LanguageAll[:]}).Ambiguities() {
+		if q.Symbol == "ml" {
+			found = len(q.Volume) == 2 && q.Volume[0] == UnitMilliLiters && q.Volume[1] == UnitMegaLiters
+		}
+		if q.Symbol == "g" || q.Symbol == "kg" {
+			t.Error(q)
+		}
+	}
+	if !found {
+		t.Error("ml and Ml must be ambiguous")
+	}
+}
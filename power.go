@@ -0,0 +1,171 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidPowerAmount = errors.New("invalid power amount")
+	ErrInvalidPowerUnit   = errors.New("invalid power unit")
+)
+
+// Power is rate of energy, e.g. appliance rating "2kW" or engine "150hp".
+type Power struct {
+	Amount float64   `json:"amount"`
+	Unit   UnitPower `json:"unit"`
+}
+
+func NewPowerFromString(s string) (*Power, error) {
+	s = normalizeUnicode(s)
+
+	var unit UnitPower
+	var maxl int
+	for _, u := range UnitPowerAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitPowerUnknown {
+		return nil, ErrInvalidPowerUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidPowerAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Power{Amount: amount, Unit: unit}, nil
+}
+
+func (s Power) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
+
+func (s *Power) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+func (s Power) Convert(unit UnitPower) Power {
+	if v, ok := TryConvertExactPower(s.Amount, s.Unit, unit); ok {
+		return Power{Amount: v, Unit: unit}
+	}
+	return Power{Amount: convertPowerApproxFromWatt(convertPowerApproxToWatt(s.Amount, s.Unit), unit), Unit: unit}
+}
+
+// Add sums powers in unit of s, or in finer unit when both are in same ladder, so 1kW + 500W is exactly 1500W.
+func (s Power) Add(o Power) Power {
+	unit := s.Unit
+	if u, ok := unitPowerLadder.finer(s.Unit, o.Unit); ok {
+		unit = u
+	}
+	return Power{Amount: s.Convert(unit).Amount + o.Convert(unit).Amount, Unit: unit}
+}
+
+// Sub subtracts powers in same unit as Add.
+func (s Power) Sub(o Power) Power { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number, e.g. count of appliances.
+func (s Power) Scale(k float64) Power { return Power{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 1kW / 250W is 4.
+func (s Power) Div(o Power) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
+// TryConvertExactPower converts along watt ladder and across metric horsepower bridge, e.g. 4PS is exactly 2941.995W.
+func TryConvertExactPower[T int32 | int64 | float32 | float64](amount T, from, to UnitPower) (v T, ok bool) {
+	if v, ok := convertByLadder(amount, from, to, unitPowerLadder); ok {
+		return v, true
+	}
+	f, ok := exactFactor(from, to, []ladder[UnitPower]{unitPowerLadder}, unitPowerBridges[:])
+	if !ok {
+		return 0, false
+	}
+	return convertByRational(amount, f)
+}
+
+type UnitPower uint8
+
+//go:generate go-enum-encoding -type=UnitPower -string
+const (
+	UnitPowerUnknown               UnitPower = iota // json:""
+	UnitMilliWatts                                  // json:"mW"
+	UnitWatts                                       // json:"W"
+	UnitKiloWatts                                   // json:"kW"
+	UnitMegaWatts                                   // json:"MW"
+	UnitGigaWatts                                   // json:"GW"
+	UnitHorsepowerMechanical                        // json:"hp"
+	UnitHorsepowerMetric                            // json:"PS"
+	UnitBritishThermalUnitsPerHour                  // json:"BTU/h"
+)
+
+var UnitPowerAll = [...]UnitPower{
+	UnitMilliWatts,
+	UnitWatts,
+	UnitKiloWatts,
+	UnitMegaWatts,
+	UnitGigaWatts,
+	UnitHorsepowerMechanical,
+	UnitHorsepowerMetric,
+	UnitBritishThermalUnitsPerHour,
+}
+
+var unitPowerLadder = ladder[UnitPower]{
+	{UnitMilliWatts, 1},
+	{UnitWatts, 1000},
+	{UnitKiloWatts, 1000},
+	{UnitMegaWatts, 1000},
+	{UnitGigaWatts, 1000},
+}
+
+// metric horsepower is 75 kgf·m/s, with standard gravity it is exactly 735.49875 W.
+var unitPowerBridges = [...]bridge[UnitPower]{
+	{from: UnitHorsepowerMetric, to: UnitWatts, factor: Rational{588399, 800}},
+}
+
+// horsepowers are not same, mechanical is 550 ft·lbf/s, metric is 75 kgf·m/s.
+// BTU/h is International Table BTU per hour.
+const (
+	wattMulApproxUnitHorsepowerMechanical       = 745.69987158227022
+	wattMulApproxUnitHorsepowerMetric           = 735.49875
+	wattMulApproxUnitBritishThermalUnitsPerHour = jouleMulApproxUnitBritishThermalUnits / 3600
+)
+
+func convertPowerApproxToWatt[T float32 | float64](amount T, unit UnitPower) T {
+	switch unit {
+	case UnitHorsepowerMechanical:
+		return amount * wattMulApproxUnitHorsepowerMechanical
+	case UnitHorsepowerMetric:
+		return amount * wattMulApproxUnitHorsepowerMetric
+	case UnitBritishThermalUnitsPerHour:
+		return amount * wattMulApproxUnitBritishThermalUnitsPerHour
+	default:
+		v, _ := convertByLadder(amount, unit, UnitWatts, unitPowerLadder)
+		return v
+	}
+}
+
+func convertPowerApproxFromWatt[T float32 | float64](amount T, unit UnitPower) T {
+	switch unit {
+	case UnitHorsepowerMechanical:
+		return amount / wattMulApproxUnitHorsepowerMechanical
+	case UnitHorsepowerMetric:
+		return amount / wattMulApproxUnitHorsepowerMetric
+	case UnitBritishThermalUnitsPerHour:
+		return amount / wattMulApproxUnitBritishThermalUnitsPerHour
+	default:
+		v, _ := convertByLadder(amount, UnitWatts, unit, unitPowerLadder)
+		return v
+	}
+}
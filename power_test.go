@@ -0,0 +1,134 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleNewPowerFromString() {
+	v, _ := NewPowerFromString("2.2kW")
+	fmt.Println(v.Amount, v.Unit, v.Convert(UnitWatts))
+	// Output: 2.2 kW 2200W
+}
+
+func TestPower(t *testing.T) {
+	tests := map[string]Power{
+		"2.2kW":      {Amount: 2.2, Unit: UnitKiloWatts},
+		"60W":        {Amount: 60, Unit: UnitWatts},
+		"5mW":        {Amount: 5, Unit: UnitMilliWatts},
+		"150hp":      {Amount: 150, Unit: UnitHorsepowerMechanical},
+		"110PS":      {Amount: 110, Unit: UnitHorsepowerMetric},
+		"12000BTU/h": {Amount: 12000, Unit: UnitBritishThermalUnitsPerHour},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewPowerFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewPowerFromString("5"); !errors.Is(err, ErrInvalidPowerUnit) {
+			t.Error(err)
+		}
+		if _, err := NewPowerFromString("kW"); !errors.Is(err, ErrInvalidPowerAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Power{Amount: 2, Unit: UnitKiloWatts})
+		if err != nil || string(b) != `{"amount":2,"unit":"kW"}` {
+			t.Error(string(b), err)
+		}
+		var v Power
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"PS"}`), &v); err != nil || v != (Power{3, UnitHorsepowerMetric}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestPowerConversion(t *testing.T) {
+	tests := [][2]Power{
+		{{1, UnitKiloWatts}, {1000, UnitWatts}},
+		{{1, UnitGigaWatts}, {1000000000000, UnitMilliWatts}},
+		{{1, UnitHorsepowerMechanical}, {745.69987158227022, UnitWatts}},
+		{{1, UnitHorsepowerMetric}, {735.49875, UnitWatts}},
+		{{1, UnitKiloWatts}, {3412.14163, UnitBritishThermalUnitsPerHour}},
+		{{100, UnitHorsepowerMetric}, {98.632, UnitHorsepowerMechanical}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-3 || c.Unit != b.Unit {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-3 || c.Unit != a.Unit {
+			t.Error(c, a)
+		}
+	}
+
+	t.Run("when horsepower, then approximate", func(t *testing.T) {
+		if v, ok := TryConvertExactPower[int64](1, UnitHorsepowerMechanical, UnitWatts); ok {
+			t.Error(v)
+		}
+		if v, ok := TryConvertExactPower[int64](1, UnitHorsepowerMetric, UnitHorsepowerMechanical); ok {
+			t.Error(v)
+		}
+		if v, ok := TryConvertExactPower[int64](3, UnitMegaWatts, UnitKiloWatts); !ok || v != 3000 {
+			t.Error(v, ok)
+		}
+	})
+
+	t.Run("when metric horsepower, then exact", func(t *testing.T) {
+		if v, ok := TryConvertExactPower(1.0, UnitHorsepowerMetric, UnitWatts); !ok || v != 735.49875 {
+			t.Error(v, ok)
+		}
+		if v, ok := TryConvertExactPower[int64](800, UnitHorsepowerMetric, UnitMilliWatts); !ok || v != 588399000 {
+			t.Error(v, ok)
+		}
+		if v, ok := TryConvertExactPower[int64](1, UnitHorsepowerMetric, UnitWatts); ok {
+			t.Error(v)
+		}
+		if v, ok := TryConvertExactPower(2941.995, UnitWatts, UnitHorsepowerMetric); !ok || math.Abs(v-4) > 1e-12 {
+			t.Error(v, ok)
+		}
+		if v := (Power{Amount: 2, Unit: UnitHorsepowerMetric}).Convert(UnitKiloWatts); math.Abs(v.Amount-1.4709975) > 1e-12 {
+			t.Error(v)
+		}
+	})
+}
+
+func TestPower_Add(t *testing.T) {
+	if v := (Power{1, UnitKiloWatts}).Add(Power{500, UnitWatts}); v != (Power{1500, UnitWatts}) {
+		t.Error(v)
+	}
+}
+
+func TestParser_ParsePower(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Power{
+		"2 kW":                  {2, UnitKiloWatts},
+		"150 horsepower":        {150, UnitHorsepowerMechanical},
+		"110 metric horsepower": {110, UnitHorsepowerMetric},
+		"249 л.с.":              {249, UnitHorsepowerMetric},
+		"1,5 кВт":               {1.5, UnitKiloWatts},
+		"9000 BTU/hr":           {9000, UnitBritishThermalUnitsPerHour},
+		"60 watts":              {60, UnitWatts},
+	}
+	for s, v := range tests {
+		if u, err := p.ParsePower(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
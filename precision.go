@@ -0,0 +1,129 @@
+package measurement
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// PreciseMass is mass with count of significant figures it was written with.
+// Significant figures do not depend on unit, so they survive conversion: "1.50kg" converts to "3.31lb".
+// Zero Significant means precision is unknown and amount is formatted as is.
+type PreciseMass struct {
+	Amount      float64  `json:"amount"`
+	Unit        UnitMass `json:"unit"`
+	Significant int      `json:"significant,omitzero"`
+}
+
+func NewPreciseMassFromString(s string) (*PreciseMass, error) { return Parser{}.ParsePreciseMass(s) }
+
+func (p Parser) ParsePreciseMass(s string) (*PreciseMass, error) {
+	m, err := p.ParseMass(s)
+	if err != nil {
+		return nil, err
+	}
+	return &PreciseMass{Amount: m.Amount, Unit: m.Unit, Significant: countSignificant(s)}, nil
+}
+
+func (s PreciseMass) String() string {
+	return formatSignificant(s.Amount, s.Significant) + s.Unit.String()
+}
+
+func (s PreciseMass) Mass() Mass { return Mass{Amount: s.Amount, Unit: s.Unit} }
+
+func (s PreciseMass) Convert(unit UnitMass) PreciseMass {
+	return PreciseMass{Amount: s.Mass().Convert(unit).Amount, Unit: unit, Significant: s.Significant}
+}
+
+// Round drops digits beyond significant figures from amount.
+func (s PreciseMass) Round() PreciseMass {
+	s.Amount = roundSignificant(s.Amount, s.Significant)
+	return s
+}
+
+// PreciseVolume is volume with count of significant figures it was written with, same as PreciseMass.
+type PreciseVolume struct {
+	Amount      float64    `json:"amount"`
+	Unit        UnitVolume `json:"unit"`
+	Significant int        `json:"significant,omitzero"`
+}
+
+func NewPreciseVolumeFromString(s string) (*PreciseVolume, error) {
+	return Parser{}.ParsePreciseVolume(s)
+}
+
+func (p Parser) ParsePreciseVolume(s string) (*PreciseVolume, error) {
+	v, err := p.ParseVolume(s)
+	if err != nil {
+		return nil, err
+	}
+	return &PreciseVolume{Amount: v.Amount, Unit: v.Unit, Significant: countSignificant(s)}, nil
+}
+
+func (s PreciseVolume) String() string {
+	return formatSignificant(s.Amount, s.Significant) + s.Unit.String()
+}
+
+func (s PreciseVolume) Volume() Volume { return Volume{Amount: s.Amount, Unit: s.Unit} }
+
+func (s PreciseVolume) Convert(unit UnitVolume) PreciseVolume {
+	return PreciseVolume{Amount: s.Volume().Convert(unit).Amount, Unit: unit, Significant: s.Significant}
+}
+
+// Round drops digits beyond significant figures from amount.
+func (s PreciseVolume) Round() PreciseVolume {
+	s.Amount = roundSignificant(s.Amount, s.Significant)
+	return s
+}
+
+// countSignificant counts significant figures in amount of measurement string.
+// Trailing zeros of integers are counted, so "1500g" keeps all four digits.
+// Fractions are exact and have unknown (zero) count.
+func countSignificant(s string) int {
+	a, _ := splitAmount(strings.TrimSpace(normalizeUnicode(s)))
+	if strings.IndexFunc(a, isFractionRune) != -1 {
+		return 0
+	}
+
+	var digits []rune
+	for _, r := range a {
+		if unicode.IsDigit(r) {
+			digits = append(digits, r)
+		}
+	}
+
+	n := len(digits)
+	for _, r := range digits {
+		if r != '0' {
+			return n
+		}
+		n--
+	}
+
+	// zero is only precise to decimal places, so they are counted instead: "0.00" is 2, "0" is unknown
+	return max(0, len(digits)-1)
+}
+
+func roundSignificant(v float64, n int) float64 {
+	if n <= 0 || v == 0 || math.IsInf(v, 0) || math.IsNaN(v) {
+		return v
+	}
+	r, _ := strconv.ParseFloat(strconv.FormatFloat(v, 'e', n-1, 64), 64)
+	return r
+}
+
+// formatSignificant formats v with n significant figures without exponent, keeping trailing zeros.
+func formatSignificant(v float64, n int) string {
+	if n <= 0 {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	if v == 0 {
+		return strconv.FormatFloat(0, 'f', n, 64)
+	}
+
+	// rounding first, so that 9.96 with 2 figures is 10 and not 10.0
+	v = roundSignificant(v, n)
+	decimals := n - 1 - int(math.Floor(math.Log10(math.Abs(v))))
+	return strconv.FormatFloat(v, 'f', max(0, decimals), 64)
+}
@@ -0,0 +1,102 @@
+package measurement
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExamplePreciseVolume_Convert() {
+	v, _ := NewPreciseVolumeFromString("1pt")
+	fmt.Println(v, v.Convert(UnitLiters), v.Volume().Convert(UnitLiters))
+	// Output: 1pt 0.5l 0.473176l
+}
+
+func TestPreciseMass(t *testing.T) {
+	tests := map[string]PreciseMass{
+		"1.50kg":   {1.5, UnitKilograms, 3},
+		"1.5kg":    {1.5, UnitKilograms, 2},
+		"1500g":    {1500, UnitGrams, 4},
+		"0.0050kg": {0.005, UnitKilograms, 2},
+		"0.00kg":   {0, UnitKilograms, 2},
+		"0kg":      {0, UnitKilograms, 0},
+		"-2.0kg":   {-2, UnitKilograms, 2},
+		"100.0 g":  {100, UnitGrams, 4},
+		"1/2lb":    {0.5, UnitPounds, 0},
+		"１．５０ｋｇ":   {1.5, UnitKilograms, 3},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			m, err := NewPreciseMassFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *m != v {
+				t.Error(*m, v)
+			}
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, s := range []string{"1.50kg", "1500g", "0.0050kg", "0.00kg", "0kg", "100.0g", "-2.0kg", "10g"} {
+			m, err := NewPreciseMassFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.String() != s {
+				t.Error(m.String(), s)
+			}
+		}
+	})
+
+	t.Run("grouping", func(t *testing.T) {
+		m, err := (Parser{Grouping: true}).ParsePreciseMass("1,000.0 g")
+		if err != nil || *m != (PreciseMass{1000, UnitGrams, 5}) {
+			t.Error(m, err)
+		}
+	})
+
+	t.Run("convert", func(t *testing.T) {
+		tests := []struct {
+			m PreciseMass
+			u UnitMass
+			s string
+		}{
+			{PreciseMass{1.5, UnitKilograms, 3}, UnitGrams, "1500g"},
+			{PreciseMass{1.5, UnitKilograms, 3}, UnitPounds, "3.31lb"},
+			{PreciseMass{1, UnitPounds, 1}, UnitGrams, "500g"},
+			{PreciseMass{1, UnitPounds, 4}, UnitGrams, "453.6g"},
+			{PreciseMass{1, UnitPounds, 0}, UnitGrams, "453.59237g"},
+			{PreciseMass{9.96, UnitGrams, 2}, UnitGrams, "10g"},
+		}
+		for _, tc := range tests {
+			if s := tc.m.Convert(tc.u).String(); s != tc.s {
+				t.Error(tc.m, s, tc.s)
+			}
+		}
+	})
+
+	t.Run("round", func(t *testing.T) {
+		if v := (PreciseMass{453.59237, UnitGrams, 3}).Round(); v != (PreciseMass{454, UnitGrams, 3}) {
+			t.Error(v)
+		}
+		if v := (PreciseMass{453.59237, UnitGrams, 0}).Round(); v != (PreciseMass{453.59237, UnitGrams, 0}) {
+			t.Error(v)
+		}
+	})
+}
+
+func TestPreciseVolume(t *testing.T) {
+	v, err := NewPreciseVolumeFromString("330.0ml")
+	if err != nil || *v != (PreciseVolume{330, UnitMilliLiters, 4}) {
+		t.Fatal(v, err)
+	}
+	if s := v.Convert(UnitLiters).String(); s != "0.3300l" {
+		t.Error(s)
+	}
+	if s := v.Convert(UnitFluidOunces).String(); s != "11.16floz" {
+		t.Error(s)
+	}
+	if s := v.Round().String(); s != "330.0ml" {
+		t.Error(s)
+	}
+}
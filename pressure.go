@@ -0,0 +1,236 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidPressureAmount = errors.New("invalid pressure amount")
+	ErrInvalidPressureUnit   = errors.New("invalid pressure unit")
+)
+
+// Pressure is force per area, e.g. tyre "2.2bar(g)" or cylinder "200bar".
+// Gauge pressure is relative to atmosphere, as most tyre and cylinder ratings are, absolute pressure is relative to vacuum.
+type Pressure struct {
+	Amount float64      `json:"amount"`
+	Unit   UnitPressure `json:"unit"`
+	Gauge  bool         `json:"gauge,omitzero"`
+}
+
+// NewPressureFromString parses "2.2bar" and gauge forms "32psig" and "2.2bar(g)".
+func NewPressureFromString(s string) (*Pressure, error) {
+	s, gauge := cutGauge(normalizeUnicode(s))
+
+	var unit UnitPressure
+	var maxl int
+	for _, u := range UnitPressureAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitPressureUnknown {
+		return nil, ErrInvalidPressureUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidPressureAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pressure{Amount: amount, Unit: unit, Gauge: gauge}, nil
+}
+
+// cutGauge removes gauge or absolute mark after unit, "(g)" and "(a)", or "g" and "a" glued to psi and bar.
+func cutGauge(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	for _, q := range [...]struct {
+		suffix, replace string
+		gauge           bool
+	}{
+		{"(g)", "", true},
+		{"(a)", "", false},
+		{"psig", "psi", true},
+		{"psia", "psi", false},
+		{"barg", "bar", true},
+		{"bara", "bar", false},
+	} {
+		if v, ok := strings.CutSuffix(s, q.suffix); ok {
+			return strings.TrimSpace(v) + q.replace, q.gauge
+		}
+	}
+	return s, false
+}
+
+func (s Pressure) String() string {
+	v := strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String()
+	if s.Gauge {
+		v += "(g)"
+	}
+	return v
+}
+
+func (s *Pressure) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+// Convert keeps gauge flag, it changes unit only.
+// Bar, pascal and atmosphere are exact to each other, inch and millimeter of mercury and psi are approximate.
+func (s Pressure) Convert(unit UnitPressure) Pressure {
+	if v, ok := TryConvertExactPressure(s.Amount, s.Unit, unit); ok {
+		return Pressure{Amount: v, Unit: unit, Gauge: s.Gauge}
+	}
+	return Pressure{Amount: convertPressureApproxFromPascal(convertPressureApproxToPascal(s.Amount, s.Unit), unit), Unit: unit, Gauge: s.Gauge}
+}
+
+// Absolute is absolute pressure of gauge pressure measured at standard atmosphere.
+func (s Pressure) Absolute() Pressure {
+	if !s.Gauge {
+		return s
+	}
+	return Pressure{Amount: s.Amount + Pressure{Amount: 1, Unit: UnitAtmospheres}.Convert(s.Unit).Amount, Unit: s.Unit}
+}
+
+// Add sums pressures in unit of s, or in finer unit when both are in same ladder, so 1MPa + 500kPa is exactly 1500kPa.
+// Result is gauge pressure when both are, when only one is gauge both are made absolute first.
+func (s Pressure) Add(o Pressure) Pressure {
+	if s.Gauge != o.Gauge {
+		s, o = s.Absolute(), o.Absolute()
+	}
+	unit := s.Unit
+	for _, l := range unitPressureLadders {
+		if u, ok := l.finer(s.Unit, o.Unit); ok {
+			unit = u
+			break
+		}
+	}
+	return Pressure{Amount: s.Convert(unit).Amount + o.Convert(unit).Amount, Unit: unit, Gauge: s.Gauge}
+}
+
+// Sub subtracts pressures in same unit as Add.
+func (s Pressure) Sub(o Pressure) Pressure {
+	if s.Gauge != o.Gauge {
+		s, o = s.Absolute(), o.Absolute()
+	}
+	return s.Add(o.Scale(-1))
+}
+
+// Scale multiplies by number.
+func (s Pressure) Scale(k float64) Pressure {
+	return Pressure{Amount: s.Amount * k, Unit: s.Unit, Gauge: s.Gauge}
+}
+
+// TryConvertExactPressure converts along ladders and across pascal bridges, e.g. 1atm is exactly 1013.25hPa.
+func TryConvertExactPressure[T int32 | int64 | float32 | float64](amount T, from, to UnitPressure) (v T, ok bool) {
+	for _, l := range unitPressureLadders {
+		if v, ok := convertByLadder(amount, from, to, l); ok {
+			return v, true
+		}
+	}
+	f, ok := exactFactor(from, to, unitPressureLadders[:], unitPressureBridges[:])
+	if !ok {
+		return 0, false
+	}
+	return convertByRational(amount, f)
+}
+
+type UnitPressure uint8
+
+//go:generate go-enum-encoding -type=UnitPressure -string
+const (
+	UnitPressureUnknown      UnitPressure = iota // json:""
+	UnitPascals                                  // json:"Pa"
+	UnitHectoPascals                             // json:"hPa"
+	UnitKiloPascals                              // json:"kPa"
+	UnitMegaPascals                              // json:"MPa"
+	UnitMilliBars                                // json:"mbar"
+	UnitBars                                     // json:"bar"
+	UnitAtmospheres                              // json:"atm"
+	UnitPoundsPerSquareInch                      // json:"psi"
+	UnitMillimetersOfMercury                     // json:"mmHg"
+	UnitInchesOfMercury                          // json:"inHg"
+)
+
+var UnitPressureAll = [...]UnitPressure{
+	UnitPascals,
+	UnitHectoPascals,
+	UnitKiloPascals,
+	UnitMegaPascals,
+	UnitMilliBars,
+	UnitBars,
+	UnitAtmospheres,
+	UnitPoundsPerSquareInch,
+	UnitMillimetersOfMercury,
+	UnitInchesOfMercury,
+}
+
+var unitPressurePascalLadder = ladder[UnitPressure]{
+	{UnitPascals, 1},
+	{UnitHectoPascals, 100},
+	{UnitKiloPascals, 10},
+	{UnitMegaPascals, 1000},
+}
+
+var unitPressureBarLadder = ladder[UnitPressure]{
+	{UnitMilliBars, 1},
+	{UnitBars, 1000},
+}
+
+var unitPressureLadders = [...]ladder[UnitPressure]{
+	unitPressurePascalLadder,
+	unitPressureBarLadder,
+}
+
+// bar is defined as exactly 100000 Pa, standard atmosphere as exactly 101325 Pa.
+var unitPressureBridges = [...]bridge[UnitPressure]{
+	{from: UnitBars, to: UnitPascals, factor: Rational{100000, 1}},
+	{from: UnitAtmospheres, to: UnitPascals, factor: Rational{101325, 1}},
+}
+
+const (
+	pascalMulApproxUnitPoundsPerSquareInch  = 6894.757293168361
+	pascalMulApproxUnitMillimetersOfMercury = 133.322387415
+	pascalMulApproxUnitInchesOfMercury      = 3386.388640341
+)
+
+func convertPressureApproxToPascal[T float32 | float64](amount T, unit UnitPressure) T {
+	switch unit {
+	case UnitPoundsPerSquareInch:
+		return amount * pascalMulApproxUnitPoundsPerSquareInch
+	case UnitMillimetersOfMercury:
+		return amount * pascalMulApproxUnitMillimetersOfMercury
+	case UnitInchesOfMercury:
+		return amount * pascalMulApproxUnitInchesOfMercury
+	default:
+		v, _ := TryConvertExactPressure(amount, unit, UnitPascals)
+		return v
+	}
+}
+
+func convertPressureApproxFromPascal[T float32 | float64](amount T, unit UnitPressure) T {
+	switch unit {
+	case UnitPoundsPerSquareInch:
+		return amount / pascalMulApproxUnitPoundsPerSquareInch
+	case UnitMillimetersOfMercury:
+		return amount / pascalMulApproxUnitMillimetersOfMercury
+	case UnitInchesOfMercury:
+		return amount / pascalMulApproxUnitInchesOfMercury
+	default:
+		v, _ := TryConvertExactPressure(amount, UnitPascals, unit)
+		return v
+	}
+}
@@ -0,0 +1,188 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleNewPressureFromString() {
+	v, _ := NewPressureFromString("2bar(g)")
+	fmt.Println(v.Amount, v.Unit, v.Gauge, v.Convert(UnitKiloPascals), v.Absolute())
+	// Output: 2 bar true 200kPa(g) 3.01325bar
+}
+
+func TestPressure(t *testing.T) {
+	tests := map[string]Pressure{
+		"2.2bar":     {Amount: 2.2, Unit: UnitBars},
+		"32psi":      {Amount: 32, Unit: UnitPoundsPerSquareInch},
+		"32psi(g)":   {Amount: 32, Unit: UnitPoundsPerSquareInch, Gauge: true},
+		"1013.25hPa": {Amount: 1013.25, Unit: UnitHectoPascals},
+		"120mmHg":    {Amount: 120, Unit: UnitMillimetersOfMercury},
+		"29.92inHg":  {Amount: 29.92, Unit: UnitInchesOfMercury},
+		"1atm":       {Amount: 1, Unit: UnitAtmospheres},
+		"200kPa(g)":  {Amount: 200, Unit: UnitKiloPascals, Gauge: true},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewPressureFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("gauge and absolute marks", func(t *testing.T) {
+		tests := map[string]Pressure{
+			"32psig":   {Amount: 32, Unit: UnitPoundsPerSquareInch, Gauge: true},
+			"14.7psia": {Amount: 14.7, Unit: UnitPoundsPerSquareInch},
+			"10barg":   {Amount: 10, Unit: UnitBars, Gauge: true},
+			"1bara":    {Amount: 1, Unit: UnitBars},
+			"2bar(a)":  {Amount: 2, Unit: UnitBars},
+		}
+		for s, v := range tests {
+			if u, err := NewPressureFromString(s); err != nil || *u != v {
+				t.Error(s, u, err)
+			}
+		}
+	})
+
+	t.Run("when space before unit, then same", func(t *testing.T) {
+		for s, v := range map[string]Pressure{"2.2 bar": {Amount: 2.2, Unit: UnitBars}, "32 psig": {Amount: 32, Unit: UnitPoundsPerSquareInch, Gauge: true}, "2 bar (g)": {Amount: 2, Unit: UnitBars, Gauge: true}} {
+			if u, err := NewPressureFromString(s); err != nil || *u != v {
+				t.Error(s, u, err)
+			}
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewPressureFromString("5"); !errors.Is(err, ErrInvalidPressureUnit) {
+			t.Error(err)
+		}
+		if _, err := NewPressureFromString("psi"); !errors.Is(err, ErrInvalidPressureAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Pressure{Amount: 2, Unit: UnitBars, Gauge: true})
+		if err != nil || string(b) != `{"amount":2,"unit":"bar","gauge":true}` {
+			t.Error(string(b), err)
+		}
+		var v Pressure
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"psi"}`), &v); err != nil || v != (Pressure{Amount: 3, Unit: UnitPoundsPerSquareInch}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestPressureConversion_Exact(t *testing.T) {
+	tests := [][2]Pressure{
+		{{Amount: 1, Unit: UnitBars}, {Amount: 100000, Unit: UnitPascals}},
+		{{Amount: 1, Unit: UnitMilliBars}, {Amount: 1, Unit: UnitHectoPascals}},
+		{{Amount: 1, Unit: UnitAtmospheres}, {Amount: 101325, Unit: UnitPascals}},
+		{{Amount: 1, Unit: UnitAtmospheres}, {Amount: 1.01325, Unit: UnitBars}},
+		{{Amount: 1, Unit: UnitMegaPascals}, {Amount: 10, Unit: UnitBars}},
+		{{Amount: 2.2, Unit: UnitBars, Gauge: true}, {Amount: 220, Unit: UnitKiloPascals, Gauge: true}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-12 || c.Unit != b.Unit || c.Gauge != b.Gauge {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-12 || c.Unit != a.Unit || c.Gauge != a.Gauge {
+			t.Error(c, a)
+		}
+	}
+
+	if v, ok := TryConvertExactPressure[int64](1, UnitAtmospheres, UnitHectoPascals); ok {
+		t.Error("1013.25hPa is not whole", v)
+	}
+	if v, ok := TryConvertExactPressure[int64](4, UnitAtmospheres, UnitHectoPascals); !ok || v != 4053 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactPressure[int64](1, UnitPoundsPerSquareInch, UnitPascals); ok {
+		t.Error("psi is approximate", v)
+	}
+}
+
+func TestPressureConversion_Approx(t *testing.T) {
+	tests := [][2]Pressure{
+		{{Amount: 32, Unit: UnitPoundsPerSquareInch}, {Amount: 2.206, Unit: UnitBars}},
+		{{Amount: 760, Unit: UnitMillimetersOfMercury}, {Amount: 1, Unit: UnitAtmospheres}},
+		{{Amount: 29.92, Unit: UnitInchesOfMercury}, {Amount: 1013.2, Unit: UnitHectoPascals}},
+		{{Amount: 1, Unit: UnitInchesOfMercury}, {Amount: 25.4, Unit: UnitMillimetersOfMercury}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-3*b.Amount || c.Unit != b.Unit {
+			t.Error(c, b)
+		}
+	}
+}
+
+func TestPressure_Absolute(t *testing.T) {
+	if v := (Pressure{Amount: 32, Unit: UnitPoundsPerSquareInch, Gauge: true}).Absolute(); v.Gauge || math.Abs(v.Amount-46.696) > 1e-3 {
+		t.Error(v)
+	}
+	if v := (Pressure{Amount: 1, Unit: UnitBars}).Absolute(); v != (Pressure{Amount: 1, Unit: UnitBars}) {
+		t.Error(v)
+	}
+}
+
+func TestPressure_Add(t *testing.T) {
+	if v := (Pressure{Amount: 1, Unit: UnitMegaPascals}).Add(Pressure{Amount: 500, Unit: UnitKiloPascals}); v != (Pressure{Amount: 1500, Unit: UnitKiloPascals}) {
+		t.Error(v)
+	}
+
+	t.Run("when both gauge, then gauge", func(t *testing.T) {
+		if v := (Pressure{Amount: 2, Unit: UnitBars, Gauge: true}).Add(Pressure{Amount: 1, Unit: UnitBars, Gauge: true}); v != (Pressure{Amount: 3, Unit: UnitBars, Gauge: true}) {
+			t.Error(v)
+		}
+	})
+
+	t.Run("when gauge and absolute, then absolute", func(t *testing.T) {
+		g, a := Pressure{Amount: 2, Unit: UnitBars, Gauge: true}, Pressure{Amount: 1, Unit: UnitBars}
+		if v := g.Add(a); v.Gauge || math.Abs(v.Amount-4.01325) > 1e-9 || v.Unit != UnitBars {
+			t.Error(v)
+		}
+		if v := a.Add(g); v.Gauge || math.Abs(v.Amount-4.01325) > 1e-9 {
+			t.Error(v)
+		}
+		if v := g.Sub(a); v.Gauge || math.Abs(v.Amount-2.01325) > 1e-9 {
+			t.Error(v)
+		}
+		if v := a.Sub(g); v.Gauge || math.Abs(v.Amount+2.01325) > 1e-9 {
+			t.Error(v)
+		}
+	})
+}
+
+func TestParser_ParsePressure(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Pressure{
+		"2.2 bar":         {Amount: 2.2, Unit: UnitBars},
+		"32psi":           {Amount: 32, Unit: UnitPoundsPerSquareInch},
+		"32 PSI":          {Amount: 32, Unit: UnitPoundsPerSquareInch},
+		"32 psig":         {Amount: 32, Unit: UnitPoundsPerSquareInch, Gauge: true},
+		"2,5 атм":         {Amount: 2.5, Unit: UnitAtmospheres},
+		"120 мм рт. ст.":  {Amount: 120, Unit: UnitMillimetersOfMercury},
+		"120 mm Hg":       {Amount: 120, Unit: UnitMillimetersOfMercury},
+		"250 kilopascals": {Amount: 250, Unit: UnitKiloPascals},
+		"1013 hPa":        {Amount: 1013, Unit: UnitHectoPascals},
+		"3 bar(g)":        {Amount: 3, Unit: UnitBars, Gauge: true},
+	}
+	for s, v := range tests {
+		if u, err := p.ParsePressure(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package measurement
+
+import "unicode"
+
+// QualifiedMass is mass with comparator, e.g. "<5mg" or "~250g".
+type QualifiedMass struct {
+	Comparator Comparator `json:"comparator,omitzero"`
+	Amount     float64    `json:"amount"`
+	Unit       UnitMass   `json:"unit"`
+}
+
+func NewQualifiedMassFromString(s string) (*QualifiedMass, error) {
+	return Parser{}.ParseQualifiedMass(s)
+}
+
+// ParseQualifiedMass parses symbols ("<5mg", "≥ 1kg", "~250g") and words ("less than 5 mg", "approximately 250 g").
+// Words after unit name substance and are ignored, as in "less than 5 mg sodium".
+func (p Parser) ParseQualifiedMass(s string) (*QualifiedMass, error) {
+	c, s := cutComparator(normalizeUnicode(s))
+	m, err := p.ParseMass(s)
+	if err != nil {
+		v, ok := p.scanSubstance(s)
+		if !ok || v.Mass == nil {
+			return nil, err
+		}
+		m = v.Mass
+	}
+	return &QualifiedMass{Comparator: c, Amount: m.Amount, Unit: m.Unit}, nil
+}
+
+func (s QualifiedMass) String() string {
+	if s.Comparator == ComparatorEqual {
+		return s.Mass().String()
+	}
+	return s.Comparator.String() + s.Mass().String()
+}
+
+func (s QualifiedMass) Mass() Mass { return Mass{Amount: s.Amount, Unit: s.Unit} }
+
+// Matches checks that actual mass satisfies qualifier.
+// Approximate value matches within 10%.
+func (s QualifiedMass) Matches(actual Mass) bool {
+	return s.Comparator.compare(actual.Convert(s.Unit).Amount, s.Amount)
+}
+
+// QualifiedVolume is volume with comparator, e.g. "≥1l".
+type QualifiedVolume struct {
+	Comparator Comparator `json:"comparator,omitzero"`
+	Amount     float64    `json:"amount"`
+	Unit       UnitVolume `json:"unit"`
+}
+
+func NewQualifiedVolumeFromString(s string) (*QualifiedVolume, error) {
+	return Parser{}.ParseQualifiedVolume(s)
+}
+
+// ParseQualifiedVolume parses same forms as ParseQualifiedMass.
+func (p Parser) ParseQualifiedVolume(s string) (*QualifiedVolume, error) {
+	c, s := cutComparator(normalizeUnicode(s))
+	v, err := p.ParseVolume(s)
+	if err != nil {
+		m, ok := p.scanSubstance(s)
+		if !ok || m.Volume == nil {
+			return nil, err
+		}
+		v = m.Volume
+	}
+	return &QualifiedVolume{Comparator: c, Amount: v.Amount, Unit: v.Unit}, nil
+}
+
+func (s QualifiedVolume) String() string {
+	if s.Comparator == ComparatorEqual {
+		return s.Volume().String()
+	}
+	return s.Comparator.String() + s.Volume().String()
+}
+
+func (s QualifiedVolume) Volume() Volume { return Volume{Amount: s.Amount, Unit: s.Unit} }
+
+// Matches checks that actual volume satisfies qualifier.
+// Approximate value matches within 10%.
+func (s QualifiedVolume) Matches(actual Volume) bool {
+	return s.Comparator.compare(actual.Convert(s.Unit).Amount, s.Amount)
+}
+
+// scanSubstance reads measure at start of s that is followed only by words, as "5 mg" in "5 mg sodium".
+func (p Parser) scanSubstance(s string) (Match, bool) {
+	i := skipSpaces(s, 0)
+	if i == len(s) || !isNumberStart(s, i) {
+		return Match{}, false
+	}
+	m, ok := p.scanMeasure(s, i, p.scanSymbols())
+	if !ok {
+		return Match{}, false
+	}
+	for _, r := range s[m.End:] {
+		if !unicode.IsLetter(r) && !unicode.IsSpace(r) && r != '-' {
+			return Match{}, false
+		}
+	}
+	return m, true
+}
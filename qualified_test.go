@@ -0,0 +1,150 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleNewQualifiedMassFromString() {
+	m, _ := NewQualifiedMassFromString("less than 5 mg")
+	fmt.Println(m, m.Matches(Mass{Amount: 0.004, Unit: UnitGrams}))
+	// Output: <5mg true
+}
+
+func TestQualifiedMass(t *testing.T) {
+	tests := map[string]QualifiedMass{
+		"<5mg":                     {ComparatorLess, 5, UnitMilligrams},
+		"< 5 mg":                   {ComparatorLess, 5, UnitMilligrams},
+		"<=5mg":                    {ComparatorLessOrEqual, 5, UnitMilligrams},
+		"≤5mg":                     {ComparatorLessOrEqual, 5, UnitMilligrams},
+		"≥ 1kg":                    {ComparatorGreaterOrEqual, 1, UnitKilograms},
+		">1kg":                     {ComparatorGreater, 1, UnitKilograms},
+		"~250g":                    {ComparatorApprox, 250, UnitGrams},
+		"≈250g":                    {ComparatorApprox, 250, UnitGrams},
+		"=250g":                    {ComparatorEqual, 250, UnitGrams},
+		"250g":                     {ComparatorEqual, 250, UnitGrams},
+		"less than 5 mg":           {ComparatorLess, 5, UnitMilligrams},
+		"Less Than 5 mg":           {ComparatorLess, 5, UnitMilligrams},
+		"approximately 250 g":      {ComparatorApprox, 250, UnitGrams},
+		"approx. 250 g":            {ComparatorApprox, 250, UnitGrams},
+		"ca. 250 g":                {ComparatorApprox, 250, UnitGrams},
+		"at least 1 kg":            {ComparatorGreaterOrEqual, 1, UnitKilograms},
+		"up to 2 kg":               {ComparatorLessOrEqual, 2, UnitKilograms},
+		"max 2kg":                  {ComparatorLessOrEqual, 2, UnitKilograms},
+		"more than 2kg":            {ComparatorGreater, 2, UnitKilograms},
+		"less than 5 mg sodium":    {ComparatorLess, 5, UnitMilligrams},
+		"<1g saturated fat":        {ComparatorLess, 1, UnitGrams},
+		"approximately 250 g rice": {ComparatorApprox, 250, UnitGrams},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			m, err := NewQualifiedMassFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *m != v {
+				t.Error(*m, v)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewQualifiedMassFromString("<5"); !errors.Is(err, ErrInvalidMassUnit) {
+			t.Error(err)
+		}
+		if _, err := NewQualifiedMassFromString("<mg"); !errors.Is(err, ErrInvalidMassAmount) {
+			t.Error(err)
+		}
+		if _, err := NewQualifiedMassFromString("<5 mg, 2g"); !errors.Is(err, ErrInvalidMassUnit) {
+			t.Error(err)
+		}
+		if _, err := NewQualifiedMassFromString("<5 ml water"); !errors.Is(err, ErrInvalidMassUnit) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		tests := map[QualifiedMass]string{
+			{ComparatorLess, 5, UnitMilligrams}:          "<5mg",
+			{ComparatorLessOrEqual, 5, UnitMilligrams}:   "<=5mg",
+			{ComparatorGreaterOrEqual, 1, UnitKilograms}: ">=1kg",
+			{ComparatorGreater, 1, UnitKilograms}:        ">1kg",
+			{ComparatorApprox, 250, UnitGrams}:           "~250g",
+			{ComparatorEqual, 250, UnitGrams}:            "250g",
+		}
+		for v, s := range tests {
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+			if u, err := NewQualifiedMassFromString(s); err != nil || *u != v {
+				t.Error(s, u, err)
+			}
+		}
+	})
+
+	t.Run("matches", func(t *testing.T) {
+		tests := []struct {
+			q      QualifiedMass
+			actual Mass
+			ok     bool
+		}{
+			{QualifiedMass{ComparatorLess, 5, UnitMilligrams}, Mass{4, UnitMilligrams}, true},
+			{QualifiedMass{ComparatorLess, 5, UnitMilligrams}, Mass{5, UnitMilligrams}, false},
+			{QualifiedMass{ComparatorLessOrEqual, 5, UnitMilligrams}, Mass{5, UnitMilligrams}, true},
+			{QualifiedMass{ComparatorGreaterOrEqual, 1, UnitKilograms}, Mass{1000, UnitGrams}, true},
+			{QualifiedMass{ComparatorGreater, 1, UnitKilograms}, Mass{1000, UnitGrams}, false},
+			{QualifiedMass{ComparatorGreater, 1, UnitKilograms}, Mass{3, UnitPounds}, true},
+			{QualifiedMass{ComparatorApprox, 250, UnitGrams}, Mass{240, UnitGrams}, true},
+			{QualifiedMass{ComparatorApprox, 250, UnitGrams}, Mass{200, UnitGrams}, false},
+			{QualifiedMass{ComparatorEqual, 250, UnitGrams}, Mass{0.25, UnitKilograms}, true},
+		}
+		for _, tc := range tests {
+			if ok := tc.q.Matches(tc.actual); ok != tc.ok {
+				t.Error(tc.q, tc.actual, ok)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		tests := map[QualifiedMass]string{
+			{ComparatorLess, 5, UnitMilligrams}:  `{"comparator":"\u003c","amount":5,"unit":"mg"}`,
+			{ComparatorEqual, 5, UnitMilligrams}: `{"amount":5,"unit":"mg"}`,
+		}
+		for v, s := range tests {
+			b, err := json.Marshal(v)
+			if err != nil || string(b) != s {
+				t.Error(string(b), err)
+			}
+			var d QualifiedMass
+			if err := json.Unmarshal(b, &d); err != nil || d != v {
+				t.Error(d, err)
+			}
+		}
+	})
+}
+
+func TestQualifiedVolume(t *testing.T) {
+	tests := map[string]QualifiedVolume{
+		"≥ 1 l":          {ComparatorGreaterOrEqual, 1, UnitLiters},
+		"~330ml":         {ComparatorApprox, 330, UnitMilliLiters},
+		"at most 500 ml": {ComparatorLessOrEqual, 500, UnitMilliLiters},
+		"≥ 1 l water":    {ComparatorGreaterOrEqual, 1, UnitLiters},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			m, err := NewQualifiedVolumeFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *m != v {
+				t.Error(*m, v)
+			}
+		})
+	}
+
+	if q := (QualifiedVolume{ComparatorGreaterOrEqual, 1, UnitLiters}); !q.Matches(Volume{1000, UnitMilliLiters}) || q.Matches(Volume{999, UnitMilliLiters}) {
+		t.Error(q)
+	}
+}
@@ -0,0 +1,291 @@
+package measurement
+
+import "errors"
+
+var (
+	ErrInvalidQuantity      = errors.New("invalid quantity")
+	ErrAmbiguousMeasureType = errors.New("ambiguous measure type")
+)
+
+// Quantity is measurement of dimension not known in advance, Type tells which field is set.
+type Quantity struct {
+	Type   MeasureType `json:"type"`
+	Mass   *Mass       `json:"mass,omitzero"`
+	Volume *Volume     `json:"volume,omitzero"`
+	Length *Length     `json:"length,omitzero"`
+	Area   *Area       `json:"area,omitzero"`
+
+	// Temperature is absolute, adding two of them is dimension mismatch.
+	Temperature *Temperature `json:"temperature,omitzero"`
+
+	Time     *Time     `json:"time,omitzero"`
+	Energy   *Energy   `json:"energy,omitzero"`
+	Power    *Power    `json:"power,omitzero"`
+	Pressure *Pressure `json:"pressure,omitzero"`
+	Speed    *Speed    `json:"speed,omitzero"`
+	FlowRate *FlowRate `json:"flow_rate,omitzero"`
+
+	// Density and Concentration are properties of substance, they are not added.
+	Density       *Density       `json:"density,omitzero"`
+	Concentration *Concentration `json:"concentration,omitzero"`
+}
+
+func (s Quantity) String() string {
+	switch {
+	case s.Mass != nil:
+		return s.Mass.String()
+	case s.Volume != nil:
+		return s.Volume.String()
+	case s.Length != nil:
+		return s.Length.String()
+	case s.Area != nil:
+		return s.Area.String()
+	case s.Temperature != nil:
+		return s.Temperature.String()
+	case s.Time != nil:
+		return s.Time.String()
+	case s.Energy != nil:
+		return s.Energy.String()
+	case s.Power != nil:
+		return s.Power.String()
+	case s.Pressure != nil:
+		return s.Pressure.String()
+	case s.Speed != nil:
+		return s.Speed.String()
+	case s.Density != nil:
+		return s.Density.String()
+	case s.FlowRate != nil:
+		return s.FlowRate.String()
+	case s.Concentration != nil:
+		return s.Concentration.String()
+	default:
+		return ""
+	}
+}
+
+// quantityParser parses one dimension, errUnit is its error for unknown unit.
+type quantityParser struct {
+	parse   func(p Parser, s string) (Quantity, error)
+	errUnit error
+}
+
+// quantityParsers has parser of each dimension in MeasureTypeAll.
+var quantityParsers = map[MeasureType]quantityParser{
+	MeasureTypeMass: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseMass(s)
+			return Quantity{Type: MeasureTypeMass, Mass: v}, err
+		},
+		errUnit: ErrInvalidMassUnit,
+	},
+	MeasureTypeVolume: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseVolume(s)
+			return Quantity{Type: MeasureTypeVolume, Volume: v}, err
+		},
+		errUnit: ErrInvalidVolumeUnit,
+	},
+	MeasureTypeLength: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseLength(s)
+			return Quantity{Type: MeasureTypeLength, Length: v}, err
+		},
+		errUnit: ErrInvalidLengthUnit,
+	},
+	MeasureTypeArea: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseArea(s)
+			return Quantity{Type: MeasureTypeArea, Area: v}, err
+		},
+		errUnit: ErrInvalidAreaUnit,
+	},
+	MeasureTypeTemperature: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseTemperature(s)
+			return Quantity{Type: MeasureTypeTemperature, Temperature: v}, err
+		},
+		errUnit: ErrInvalidTemperatureUnit,
+	},
+	MeasureTypeTime: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseTime(s)
+			return Quantity{Type: MeasureTypeTime, Time: v}, err
+		},
+		errUnit: ErrInvalidTimeUnit,
+	},
+	MeasureTypeEnergy: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseEnergy(s)
+			return Quantity{Type: MeasureTypeEnergy, Energy: v}, err
+		},
+		errUnit: ErrInvalidEnergyUnit,
+	},
+	MeasureTypePower: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParsePower(s)
+			return Quantity{Type: MeasureTypePower, Power: v}, err
+		},
+		errUnit: ErrInvalidPowerUnit,
+	},
+	MeasureTypePressure: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParsePressure(s)
+			return Quantity{Type: MeasureTypePressure, Pressure: v}, err
+		},
+		errUnit: ErrInvalidPressureUnit,
+	},
+	MeasureTypeSpeed: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseSpeed(s)
+			return Quantity{Type: MeasureTypeSpeed, Speed: v}, err
+		},
+		errUnit: ErrInvalidSpeedUnit,
+	},
+	MeasureTypeDensity: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseDensity(s)
+			return Quantity{Type: MeasureTypeDensity, Density: v}, err
+		},
+		errUnit: ErrInvalidDensityUnit,
+	},
+	MeasureTypeFlowRate: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseFlowRate(s)
+			return Quantity{Type: MeasureTypeFlowRate, FlowRate: v}, err
+		},
+		errUnit: ErrInvalidFlowRateUnit,
+	},
+	MeasureTypeConcentration: {
+		parse: func(p Parser, s string) (Quantity, error) {
+			v, err := p.ParseConcentration(s)
+			return Quantity{Type: MeasureTypeConcentration, Concentration: v}, err
+		},
+		errUnit: ErrInvalidConcentrationUnit,
+	},
+}
+
+func NewQuantityFromString(s string) (*Quantity, error) {
+	return Parser{}.ParseQuantity(s, MeasureTypeUndefined)
+}
+
+// ParseQuantity parses measurement of any dimension.
+// When unit is spelled same in several dimensions, hint picks one of them, without hint it is ErrAmbiguousMeasureType.
+// Hint does not reject other dimension when only it matches.
+func (p Parser) ParseQuantity(s string, hint MeasureType) (*Quantity, error) {
+	vs, err := p.Interpretations(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(vs) == 1 {
+		return &vs[0], nil
+	}
+	for _, v := range vs {
+		if v.Type == hint {
+			return &v, nil
+		}
+	}
+	return nil, ErrAmbiguousMeasureType
+}
+
+// Interpretations are values of all dimensions s parses as, in order of MeasureTypeAll.
+// When none, error is from dimension that knows unit, as it describes wrong amount better.
+func (p Parser) Interpretations(s string) ([]Quantity, error) {
+	var vs []Quantity
+	err := ErrInvalidQuantity
+
+	for _, t := range MeasureTypeAll {
+		q := quantityParsers[t]
+		v, e := q.parse(p, s)
+		if e == nil {
+			vs = append(vs, v)
+			continue
+		}
+		if !errors.Is(e, q.errUnit) {
+			err = e
+		}
+	}
+
+	if len(vs) == 0 {
+		return nil, err
+	}
+	return vs, nil
+}
+
+// add sums quantities of same dimension.
+func (s Quantity) add(o Quantity) (Quantity, bool) {
+	if s.Type != o.Type {
+		return Quantity{}, false
+	}
+	switch {
+	case s.Mass != nil && o.Mass != nil:
+		v := s.Mass.Add(*o.Mass)
+		return Quantity{Type: s.Type, Mass: &v}, true
+	case s.Volume != nil && o.Volume != nil:
+		v := s.Volume.Add(*o.Volume)
+		return Quantity{Type: s.Type, Volume: &v}, true
+	case s.Length != nil && o.Length != nil:
+		v := s.Length.Add(*o.Length)
+		return Quantity{Type: s.Type, Length: &v}, true
+	case s.Area != nil && o.Area != nil:
+		v := s.Area.Add(*o.Area)
+		return Quantity{Type: s.Type, Area: &v}, true
+	case s.Time != nil && o.Time != nil:
+		v := s.Time.Add(*o.Time)
+		return Quantity{Type: s.Type, Time: &v}, true
+	case s.Energy != nil && o.Energy != nil:
+		v := s.Energy.Add(*o.Energy)
+		return Quantity{Type: s.Type, Energy: &v}, true
+	case s.Power != nil && o.Power != nil:
+		v := s.Power.Add(*o.Power)
+		return Quantity{Type: s.Type, Power: &v}, true
+	case s.Pressure != nil && o.Pressure != nil:
+		v := s.Pressure.Add(*o.Pressure)
+		return Quantity{Type: s.Type, Pressure: &v}, true
+	case s.Speed != nil && o.Speed != nil:
+		v := s.Speed.Add(*o.Speed)
+		return Quantity{Type: s.Type, Speed: &v}, true
+	case s.FlowRate != nil && o.FlowRate != nil:
+		v := s.FlowRate.Add(*o.FlowRate)
+		return Quantity{Type: s.Type, FlowRate: &v}, true
+	default:
+		return Quantity{}, false
+	}
+}
+
+// scale multiplies by number, it is not ok for temperature, density and concentration, they are not scaled.
+func (s Quantity) scale(k float64) (Quantity, bool) {
+	switch {
+	case s.Mass != nil:
+		v := s.Mass.Scale(k)
+		return Quantity{Type: s.Type, Mass: &v}, true
+	case s.Volume != nil:
+		v := s.Volume.Scale(k)
+		return Quantity{Type: s.Type, Volume: &v}, true
+	case s.Length != nil:
+		v := s.Length.Scale(k)
+		return Quantity{Type: s.Type, Length: &v}, true
+	case s.Area != nil:
+		v := s.Area.Scale(k)
+		return Quantity{Type: s.Type, Area: &v}, true
+	case s.Time != nil:
+		v := s.Time.Scale(k)
+		return Quantity{Type: s.Type, Time: &v}, true
+	case s.Energy != nil:
+		v := s.Energy.Scale(k)
+		return Quantity{Type: s.Type, Energy: &v}, true
+	case s.Power != nil:
+		v := s.Power.Scale(k)
+		return Quantity{Type: s.Type, Power: &v}, true
+	case s.Pressure != nil:
+		v := s.Pressure.Scale(k)
+		return Quantity{Type: s.Type, Pressure: &v}, true
+	case s.Speed != nil:
+		v := s.Speed.Scale(k)
+		return Quantity{Type: s.Type, Speed: &v}, true
+	case s.FlowRate != nil:
+		v := s.FlowRate.Scale(k)
+		return Quantity{Type: s.Type, FlowRate: &v}, true
+	default:
+		return Quantity{}, false
+	}
+}
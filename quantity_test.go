@@ -0,0 +1,150 @@
+package measurement
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleNewQuantityFromString() {
+	for _, s := range []string{"500 g", "330 ml"} {
+		q, _ := NewQuantityFromString(s)
+		fmt.Println(q.Type, q)
+	}
+	// Output:
+	// mass 500g
+	// volume 330ml
+}
+
+func TestQuantity(t *testing.T) {
+	t.Run("every measure type has parser", func(t *testing.T) {
+		if len(MeasureTypeAll) != len(seq_string_MeasureType)-1 {
+			t.Error("MeasureTypeAll misses measure type")
+		}
+		for _, q := range MeasureTypeAll {
+			if _, ok := quantityParsers[q]; !ok {
+				t.Error(q)
+			}
+		}
+	})
+
+	t.Run("canonical symbol has single interpretation", func(t *testing.T) {
+		for _, u := range UnitMassAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Mass != (Mass{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitVolumeAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Volume != (Volume{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitLengthAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Length != (Length{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitAreaAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Area != (Area{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitTemperatureAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Temperature != (Temperature{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitTimeAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Time != (Time{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitEnergyAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Energy != (Energy{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitPowerAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Power != (Power{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitPressureAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Pressure != (Pressure{Amount: 1, Unit: u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitSpeedAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Speed != (Speed{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitDensityAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Density != (Density{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitFlowRateAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].FlowRate != (FlowRate{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+		for _, u := range UnitConcentrationAll {
+			vs, err := Parser{}.Interpretations("1" + u.String())
+			if err != nil || len(vs) != 1 || *vs[0].Concentration != (Concentration{1, u}) {
+				t.Error(u, vs, err)
+			}
+		}
+	})
+
+	t.Run("when ambiguous, then hint picks dimension", func(t *testing.T) {
+		p := Parser{Languages: []Language{{Decimal: '.', Mass: map[string]UnitMass{"u": UnitGrams}, Volume: map[string]UnitVolume{"u": UnitLiters}}}}
+
+		vs, err := p.Interpretations("2 u")
+		if err != nil || len(vs) != 2 || vs[0].Type != MeasureTypeMass || vs[1].Type != MeasureTypeVolume {
+			t.Fatal(vs, err)
+		}
+
+		if _, err := p.ParseQuantity("2 u", MeasureTypeUndefined); !errors.Is(err, ErrAmbiguousMeasureType) {
+			t.Error(err)
+		}
+		if q, err := p.ParseQuantity("2 u", MeasureTypeVolume); err != nil || *q.Volume != (Volume{2, UnitLiters}) || q.Mass != nil {
+			t.Error(q, err)
+		}
+		if q, err := p.ParseQuantity("2 u", MeasureTypeMass); err != nil || *q.Mass != (Mass{2, UnitGrams}) {
+			t.Error(q, err)
+		}
+	})
+
+	t.Run("when hint does not match, then only dimension is used", func(t *testing.T) {
+		if q, err := (Parser{}).ParseQuantity("2kg", MeasureTypeVolume); err != nil || q.Type != MeasureTypeMass {
+			t.Error(q, err)
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		tests := map[string]error{
+			"2 parsecs": ErrInvalidQuantity,
+			"":          ErrInvalidQuantity,
+			"1..5 kg":   ErrInvalidMassAmount,
+			"1..5 ml":   ErrInvalidVolumeAmount,
+		}
+		for s, e := range tests {
+			if _, err := NewQuantityFromString(s); !errors.Is(err, e) {
+				t.Error(s, err)
+			}
+		}
+	})
+}
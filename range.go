@@ -0,0 +1,183 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidRange = errors.New("invalid range")
+
+// MassRange is closed interval of masses, e.g. "2-3kg".
+type MassRange struct {
+	Min  float64  `json:"min"`
+	Max  float64  `json:"max"`
+	Unit UnitMass `json:"unit"`
+}
+
+func NewMassRangeFromString(s string) (*MassRange, error) { return Parser{}.ParseMassRange(s) }
+
+// ParseMassRange parses "2-3 kg", "2–3 kg", "2 to 3 kg", "from 2 to 3 kg" and "500 g - 1 kg".
+// When units differ, range is in unit of lower bound.
+func (p Parser) ParseMassRange(s string) (*MassRange, error) {
+	a, b, ok := splitRange(s)
+	if !ok {
+		return nil, ErrInvalidRange
+	}
+
+	hi, err := p.ParseMass(b)
+	if err != nil {
+		return nil, err
+	}
+
+	lo, err := p.ParseMass(a)
+	if errors.Is(err, ErrInvalidMassUnit) {
+		amount, ok := parseAmount(strings.TrimSpace(normalizeUnicode(a)), p.formats(), p.Grouping)
+		if !ok {
+			return nil, ErrInvalidMassAmount
+		}
+		lo, err = &Mass{Amount: amount, Unit: hi.Unit}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r := MassRange{Min: lo.Amount, Max: hi.Convert(lo.Unit).Amount, Unit: lo.Unit}
+	if r.Min > r.Max {
+		return nil, ErrInvalidRange
+	}
+	return &r, nil
+}
+
+func (s MassRange) String() string {
+	return strconv.FormatFloat(s.Min, 'f', -1, 64) + "-" + strconv.FormatFloat(s.Max, 'f', -1, 64) + s.Unit.String()
+}
+
+func (s MassRange) Convert(unit UnitMass) MassRange {
+	return MassRange{
+		Min:  Mass{Amount: s.Min, Unit: s.Unit}.Convert(unit).Amount,
+		Max:  Mass{Amount: s.Max, Unit: s.Unit}.Convert(unit).Amount,
+		Unit: unit,
+	}
+}
+
+func (s MassRange) Contains(m Mass) bool {
+	v := m.Convert(s.Unit).Amount
+	return s.Min <= v && v <= s.Max
+}
+
+func (s MassRange) Overlaps(o MassRange) bool {
+	_, ok := s.Intersect(o)
+	return ok
+}
+
+// Intersect is common part of ranges in unit of s.
+func (s MassRange) Intersect(o MassRange) (MassRange, bool) {
+	o = o.Convert(s.Unit)
+	r := MassRange{Min: max(s.Min, o.Min), Max: min(s.Max, o.Max), Unit: s.Unit}
+	if r.Min > r.Max {
+		return MassRange{}, false
+	}
+	return r, true
+}
+
+// VolumeRange is closed interval of volumes, e.g. "500-750ml".
+type VolumeRange struct {
+	Min  float64    `json:"min"`
+	Max  float64    `json:"max"`
+	Unit UnitVolume `json:"unit"`
+}
+
+func NewVolumeRangeFromString(s string) (*VolumeRange, error) { return Parser{}.ParseVolumeRange(s) }
+
+// ParseVolumeRange parses same forms as ParseMassRange.
+func (p Parser) ParseVolumeRange(s string) (*VolumeRange, error) {
+	a, b, ok := splitRange(s)
+	if !ok {
+		return nil, ErrInvalidRange
+	}
+
+	hi, err := p.ParseVolume(b)
+	if err != nil {
+		return nil, err
+	}
+
+	lo, err := p.ParseVolume(a)
+	if errors.Is(err, ErrInvalidVolumeUnit) {
+		amount, ok := parseAmount(strings.TrimSpace(normalizeUnicode(a)), p.formats(), p.Grouping)
+		if !ok {
+			return nil, ErrInvalidVolumeAmount
+		}
+		lo, err = &Volume{Amount: amount, Unit: hi.Unit}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	r := VolumeRange{Min: lo.Amount, Max: hi.Convert(lo.Unit).Amount, Unit: lo.Unit}
+	if r.Min > r.Max {
+		return nil, ErrInvalidRange
+	}
+	return &r, nil
+}
+
+func (s VolumeRange) String() string {
+	return strconv.FormatFloat(s.Min, 'f', -1, 64) + "-" + strconv.FormatFloat(s.Max, 'f', -1, 64) + s.Unit.String()
+}
+
+func (s VolumeRange) Convert(unit UnitVolume) VolumeRange {
+	return VolumeRange{
+		Min:  Volume{Amount: s.Min, Unit: s.Unit}.Convert(unit).Amount,
+		Max:  Volume{Amount: s.Max, Unit: s.Unit}.Convert(unit).Amount,
+		Unit: unit,
+	}
+}
+
+func (s VolumeRange) Contains(v Volume) bool {
+	a := v.Convert(s.Unit).Amount
+	return s.Min <= a && a <= s.Max
+}
+
+func (s VolumeRange) Overlaps(o VolumeRange) bool {
+	_, ok := s.Intersect(o)
+	return ok
+}
+
+// Intersect is common part of ranges in unit of s.
+func (s VolumeRange) Intersect(o VolumeRange) (VolumeRange, bool) {
+	o = o.Convert(s.Unit)
+	r := VolumeRange{Min: max(s.Min, o.Min), Max: min(s.Max, o.Max), Unit: s.Unit}
+	if r.Min > r.Max {
+		return VolumeRange{}, false
+	}
+	return r, true
+}
+
+func isRangeDash(r rune) bool { return r == '-' || r == '–' || r == '—' }
+
+// splitRange splits "a-b", "a–b", "a—b", "a to b" and "from a to b" into bounds.
+func splitRange(s string) (a, b string, ok bool) {
+	s = strings.TrimSpace(s)
+	if len(s) > 5 && strings.EqualFold(s[:5], "from ") {
+		s = s[5:]
+	}
+
+	for i := 1; i+4 <= len(s); i++ {
+		if strings.EqualFold(s[i:i+4], " to ") {
+			a, b = strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+4:])
+			return a, b, a != "" && b != ""
+		}
+	}
+
+	// dash at start is sign of lower bound
+	i := strings.IndexFunc(s[min(1, len(s)):], isRangeDash)
+	if i == -1 {
+		return "", "", false
+	}
+	i++
+
+	_, size := utf8.DecodeRuneInString(s[i:])
+	a, b = strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+size:])
+	return a, b, a != "" && b != ""
+}
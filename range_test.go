@@ -0,0 +1,158 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleNewMassRangeFromString() {
+	r, _ := NewMassRangeFromString("2–3 kg")
+	fmt.Println(r, r.Contains(Mass{Amount: 2500, Unit: UnitGrams}))
+	// Output: 2-3kg true
+}
+
+func TestMassRange(t *testing.T) {
+	tests := map[string]MassRange{
+		"2-3kg":           {2, 3, UnitKilograms},
+		"2 - 3 kg":        {2, 3, UnitKilograms},
+		"2–3 kg":          {2, 3, UnitKilograms},
+		"2—3kg":           {2, 3, UnitKilograms},
+		"2 to 3 kg":       {2, 3, UnitKilograms},
+		"from 2 to 3 kg":  {2, 3, UnitKilograms},
+		"From 2kg To 3kg": {2, 3, UnitKilograms},
+		"500g-1kg":        {500, 1000, UnitGrams},
+		"1.5-2.5 kg":      {1.5, 2.5, UnitKilograms},
+		"2-2kg":           {2, 2, UnitKilograms},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			r, err := NewMassRangeFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *r != v {
+				t.Error(*r, v)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		tests := map[string]error{
+			"3kg":     ErrInvalidRange,
+			"3-2kg":   ErrInvalidRange,
+			"-3kg":    ErrInvalidRange,
+			"2-kg":    ErrInvalidMassAmount,
+			"a-3kg":   ErrInvalidMassAmount,
+			"2-3":     ErrInvalidMassUnit,
+			"2kg-3ml": ErrInvalidMassUnit,
+		}
+		for s, e := range tests {
+			if _, err := NewMassRangeFromString(s); !errors.Is(err, e) {
+				t.Error(s, err, e)
+			}
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		if s := (MassRange{0.5, 1.5, UnitKilograms}).String(); s != "0.5-1.5kg" {
+			t.Error(s)
+		}
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		r := MassRange{2, 3, UnitKilograms}
+		for _, m := range []Mass{{2, UnitKilograms}, {3, UnitKilograms}, {2500, UnitGrams}, {5, UnitPounds}} {
+			if !r.Contains(m) {
+				t.Error(m)
+			}
+		}
+		for _, m := range []Mass{{1, UnitKilograms}, {3001, UnitGrams}, {8, UnitPounds}} {
+			if r.Contains(m) {
+				t.Error(m)
+			}
+		}
+	})
+
+	t.Run("overlaps and intersect", func(t *testing.T) {
+		r := MassRange{2, 3, UnitKilograms}
+		if v, ok := r.Intersect(MassRange{2500, 4000, UnitGrams}); !ok || v != (MassRange{2.5, 3, UnitKilograms}) {
+			t.Error(v, ok)
+		}
+		if v, ok := r.Intersect(MassRange{3, 4, UnitKilograms}); !ok || v != (MassRange{3, 3, UnitKilograms}) {
+			t.Error(v, ok)
+		}
+		if !r.Overlaps(MassRange{1, 5, UnitKilograms}) {
+			t.Error("must overlap")
+		}
+		if r.Overlaps(MassRange{100, 200, UnitGrams}) {
+			t.Error("must not overlap")
+		}
+	})
+
+	t.Run("convert", func(t *testing.T) {
+		if v := (MassRange{2, 3, UnitKilograms}).Convert(UnitGrams); v != (MassRange{2000, 3000, UnitGrams}) {
+			t.Error(v)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		r := MassRange{2, 3, UnitKilograms}
+		b, err := json.Marshal(r)
+		if err != nil || string(b) != `{"min":2,"max":3,"unit":"kg"}` {
+			t.Fatal(string(b), err)
+		}
+		var d MassRange
+		if err := json.Unmarshal(b, &d); err != nil || d != r {
+			t.Error(d, err)
+		}
+	})
+}
+
+func TestVolumeRange(t *testing.T) {
+	tests := map[string]VolumeRange{
+		"500-750 ml":       {500, 750, UnitMilliLiters},
+		"500–750ml":        {500, 750, UnitMilliLiters},
+		"1 to 2 l":         {1, 2, UnitLiters},
+		"from 250ml to 1l": {250, 1000, UnitMilliLiters},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			r, err := NewVolumeRangeFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *r != v {
+				t.Error(*r, v)
+			}
+		})
+	}
+
+	t.Run("parser", func(t *testing.T) {
+		p := Parser{Languages: []Language{LanguageRussian}}
+		if r, err := p.ParseVolumeRange("1,5-2 л"); err != nil || *r != (VolumeRange{1.5, 2, UnitLiters}) {
+			t.Error(r, err)
+		}
+	})
+
+	t.Run("contains, overlaps, intersect", func(t *testing.T) {
+		r := VolumeRange{500, 750, UnitMilliLiters}
+		if !r.Contains(Volume{0.6, UnitLiters}) || r.Contains(Volume{1, UnitLiters}) {
+			t.Error(r)
+		}
+		if v, ok := r.Intersect(VolumeRange{0.7, 1, UnitLiters}); !ok || v != (VolumeRange{700, 750, UnitMilliLiters}) {
+			t.Error(v, ok)
+		}
+		if r.Overlaps(VolumeRange{1, 2, UnitLiters}) {
+			t.Error("must not overlap")
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(VolumeRange{500, 750, UnitMilliLiters})
+		if err != nil || string(b) != `{"min":500,"max":750,"unit":"ml"}` {
+			t.Error(string(b), err)
+		}
+	})
+}
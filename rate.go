@@ -0,0 +1,96 @@
+package measurement
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidRate = errors.New("invalid rate")
+
+type rateQuantity[T any] interface {
+	Scale(k float64) T
+	Div(o T) float64
+	String() string
+}
+
+// Rate is amount of one quantity per amount of another, e.g. dose "5mg/kg" or nutrition "2g/100ml".
+// Denominator keeps its amount, so "per 100ml" is not turned into "per ml".
+type Rate[N rateQuantity[N], D rateQuantity[D]] struct {
+	Numerator   N `json:"numerator"`
+	Denominator D `json:"denominator"`
+}
+
+// ParseRate parses "5mg/kg", "2 g / 100 ml" and "5 mg per kg" with parsers of each side, e.g. Parser.ParseMass.
+// Side without amount is one unit, so "mg/kg" is "1mg/1kg".
+func ParseRate[N rateQuantity[N], D rateQuantity[D]](s string, numerator func(string) (*N, error), denominator func(string) (*D, error)) (*Rate[N, D], error) {
+	a, b, ok := splitRate(s)
+	if !ok {
+		return nil, ErrInvalidRate
+	}
+
+	n, err := numerator(withAmount(a))
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := denominator(withAmount(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rate[N, D]{Numerator: *n, Denominator: *d}, nil
+}
+
+// splitRate splits s at slash or "per" after numerator unit, slash in amount "1/2 tsp/kg" is fraction.
+func splitRate(s string) (numerator, denominator string, ok bool) {
+	i := strings.IndexFunc(s, func(r rune) bool { return !isAmountRune(r) })
+	if i == -1 {
+		return "", "", false
+	}
+
+	if j := strings.IndexAny(s[i:], "/⁄"); j != -1 {
+		_, size := utf8.DecodeRuneInString(s[i+j:])
+		return strings.TrimSpace(s[:i+j]), strings.TrimSpace(s[i+j+size:]), true
+	}
+	if a, b, ok := strings.Cut(s[i:], " per "); ok {
+		return strings.TrimSpace(s[:i] + a), strings.TrimSpace(b), true
+	}
+	return "", "", false
+}
+
+// withAmount prefixes one to quantity that is only unit.
+func withAmount(s string) string {
+	if amount, _ := splitAmount(s); amount == "" {
+		return "1" + s
+	}
+	return s
+}
+
+// String omits denominator amount of one, e.g. "5mg/kg" and "2g/100ml".
+func (s Rate[N, D]) String() string {
+	d := s.Denominator.String()
+	if v, ok := strings.CutPrefix(d, "1"); ok {
+		if r, _ := utf8.DecodeRuneInString(v); !isAmountRune(r) {
+			d = v
+		}
+	}
+	return s.Numerator.String() + "/" + d
+}
+
+// Mul is numerator for given amount of denominator, e.g. 5mg/kg for 70kg is 350mg.
+func (s Rate[N, D]) Mul(d D) N { return s.Numerator.Scale(d.Div(s.Denominator)) }
+
+// Per is same rate for another amount of denominator, in its unit, e.g. 2g/100ml per 1l is 20g/l.
+func (s Rate[N, D]) Per(d D) Rate[N, D] { return Rate[N, D]{Numerator: s.Mul(d), Denominator: d} }
+
+// ConvertRate converts numerator and denominator to units along their ladders, denominator keeps its amount.
+func ConvertRate[N interface {
+	rateQuantity[N]
+	Convert(UN) N
+}, D interface {
+	rateQuantity[D]
+	Convert(UD) D
+}, UN, UD comparable](s Rate[N, D], numerator UN, denominator UD) Rate[N, D] {
+	return Rate[N, D]{Numerator: s.Numerator.Convert(numerator), Denominator: s.Denominator.Convert(denominator)}
+}
@@ -0,0 +1,124 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleRate_Mul() {
+	dose, _ := ParseRate("5mg/kg", NewMassFromString, NewMassFromString)
+	fmt.Println(dose, dose.Mul(Mass{Amount: 70, Unit: UnitKilograms}))
+	// Output: 5mg/kg 350mg
+}
+
+func TestParseRate(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish}}
+
+	t.Run("mass per mass", func(t *testing.T) {
+		tests := map[string]Rate[Mass, Mass]{
+			"5mg/kg":       {Mass{5, UnitMilligrams}, Mass{1, UnitKilograms}},
+			"5 mg / kg":    {Mass{5, UnitMilligrams}, Mass{1, UnitKilograms}},
+			"5 mg per kg":  {Mass{5, UnitMilligrams}, Mass{1, UnitKilograms}},
+			"mg/kg":        {Mass{1, UnitMilligrams}, Mass{1, UnitKilograms}},
+			"30g/100g":     {Mass{30, UnitGrams}, Mass{100, UnitGrams}},
+			"1/2 mg/2 kg":  {Mass{0.5, UnitMilligrams}, Mass{2, UnitKilograms}},
+			"1 ½ mg/kg":    {Mass{1.5, UnitMilligrams}, Mass{1, UnitKilograms}},
+			"5 mcg⁄100 lb": {Mass{5, UnitMicrograms}, Mass{100, UnitPounds}},
+		}
+		for s, v := range tests {
+			t.Run(s, func(t *testing.T) {
+				r, err := ParseRate(s, p.ParseMass, p.ParseMass)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *r != v {
+					t.Error(*r, v)
+				}
+			})
+		}
+	})
+
+	t.Run("mass per volume", func(t *testing.T) {
+		r, err := ParseRate("g/100ml", p.ParseMass, p.ParseVolume)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *r != (Rate[Mass, Volume]{Mass{1, UnitGrams}, Volume{100, UnitMilliLiters}}) {
+			t.Error(*r)
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		tests := map[string]error{
+			"5mg":       ErrInvalidRate,
+			"5":         ErrInvalidRate,
+			"":          ErrInvalidRate,
+			"5mg/":      ErrInvalidVolumeUnit,
+			"5/100ml":   ErrInvalidRate,
+			"5 ml/l":    ErrInvalidMassUnit,
+			"5 mg/5 kg": ErrInvalidVolumeUnit,
+		}
+		for s, e := range tests {
+			if _, err := ParseRate(s, p.ParseMass, p.ParseVolume); !errors.Is(err, e) {
+				t.Error(s, err)
+			}
+		}
+	})
+}
+
+func TestRate(t *testing.T) {
+	t.Run("string", func(t *testing.T) {
+		tests := map[string]string{
+			"5mg/kg":      "5mg/kg",
+			"2 g / 100 g": "2g/100g",
+			"1.5g/10g":    "1.5g/10g",
+			"3g/0.5kg":    "3g/0.5kg",
+		}
+		for s, want := range tests {
+			r, err := ParseRate(s, Parser{}.ParseMass, Parser{}.ParseMass)
+			if err != nil || r.String() != want {
+				t.Error(s, r, err)
+			}
+		}
+	})
+
+	t.Run("mul", func(t *testing.T) {
+		sugar := Rate[Mass, Volume]{Mass{2, UnitGrams}, Volume{100, UnitMilliLiters}}
+		if v := sugar.Mul(Volume{1.5, UnitLiters}); v != (Mass{30, UnitGrams}) {
+			t.Error(v)
+		}
+
+		fuel := Rate[Volume, Length]{Volume{6, UnitLiters}, Length{100, UnitKiloMeters}}
+		if v := fuel.Mul(Length{250, UnitKiloMeters}); v != (Volume{15, UnitLiters}) {
+			t.Error(v)
+		}
+	})
+
+	t.Run("per", func(t *testing.T) {
+		r := Rate[Mass, Volume]{Mass{2, UnitGrams}, Volume{100, UnitMilliLiters}}
+		if v := r.Per(Volume{1, UnitLiters}); v != (Rate[Mass, Volume]{Mass{20, UnitGrams}, Volume{1, UnitLiters}}) {
+			t.Error(v)
+		}
+	})
+
+	t.Run("convert", func(t *testing.T) {
+		r := Rate[Mass, Volume]{Mass{2, UnitGrams}, Volume{100, UnitMilliLiters}}
+		if v := ConvertRate(r, UnitMilligrams, UnitLiters); v != (Rate[Mass, Volume]{Mass{2000, UnitMilligrams}, Volume{0.1, UnitLiters}}) {
+			t.Error(v)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		r := Rate[Mass, Mass]{Mass{5, UnitMilligrams}, Mass{1, UnitKilograms}}
+		b, err := json.Marshal(r)
+		if s := `{"numerator":{"amount":5,"unit":"mg"},"denominator":{"amount":1,"unit":"kg"}}`; err != nil || string(b) != s {
+			t.Error(string(b), err)
+		}
+		var d Rate[Mass, Mass]
+		if err := json.Unmarshal(b, &d); err != nil || d != r {
+			t.Error(d, err)
+		}
+	})
+}
@@ -0,0 +1,288 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidRational = errors.New("invalid rational")
+
+// Rational is exact fractional amount Num/Den, as in "1 1/2 cups" or "⅓ cup".
+// Values made by this package are normalized: Den is positive and fraction is irreducible.
+// Zero Den is read as 0, so zero value is 0 as for Mass and Volume.
+type Rational struct {
+	Num int64
+	Den int64
+}
+
+// norm is normalized r, with zero Den read as 0.
+func (r Rational) norm() Rational {
+	if r.Den == 0 {
+		return Rational{Num: 0, Den: 1}
+	}
+	return NewRational(r.Num, r.Den)
+}
+
+// Equal compares values, so 2/4 equals 1/2 and zero value equals 0/1.
+func (r Rational) Equal(o Rational) bool { return r.norm() == o.norm() }
+
+// NewRational makes normalized fraction, den must not be zero.
+func NewRational(num, den int64) Rational {
+	if den < 0 {
+		num, den = -num, -den
+	}
+	if g := gcd(num, den); g > 1 {
+		num, den = num/g, den/g
+	}
+	return Rational{Num: num, Den: den}
+}
+
+func gcd(a, b int64) int64 {
+	if a < 0 {
+		a = -a
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+var vulgarFractions = map[rune]Rational{
+	'½': {1, 2},
+	'⅓': {1, 3},
+	'⅔': {2, 3},
+	'¼': {1, 4},
+	'¾': {3, 4},
+	'⅕': {1, 5},
+	'⅖': {2, 5},
+	'⅗': {3, 5},
+	'⅘': {4, 5},
+	'⅙': {1, 6},
+	'⅚': {5, 6},
+	'⅐': {1, 7},
+	'⅛': {1, 8},
+	'⅜': {3, 8},
+	'⅝': {5, 8},
+	'⅞': {7, 8},
+	'⅑': {1, 9},
+	'⅒': {1, 10},
+}
+
+func isFractionRune(r rune) bool {
+	_, ok := vulgarFractions[r]
+	return ok || r == '/' || r == '⁄'
+}
+
+// NewRationalFromString parses integers, decimals, fractions and mixed numbers: "3", "0.25", "1/2", "1 1/2", "½", "1½".
+func NewRationalFromString(s string) (Rational, error) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, "⁄", "/"))
+
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimSpace(strings.TrimPrefix(s, "-"))
+	if s == "" {
+		return Rational{}, ErrInvalidRational
+	}
+
+	var whole, fraction string
+	if r, ok := vulgarFractions[lastRune(s)]; ok {
+		whole = strings.TrimSpace(strings.TrimSuffix(s, string(lastRune(s))))
+		fraction = strconv.FormatInt(r.Num, 10) + "/" + strconv.FormatInt(r.Den, 10)
+	} else if i := strings.LastIndexByte(s, ' '); i != -1 && strings.Contains(s, "/") {
+		whole, fraction = strings.TrimSpace(s[:i]), s[i+1:]
+	} else if strings.Contains(s, "/") {
+		fraction = s
+	} else {
+		whole = s
+	}
+
+	v := Rational{Num: 0, Den: 1}
+
+	if whole != "" {
+		w, ok := parseDecimalRational(whole)
+		if !ok {
+			return Rational{}, ErrInvalidRational
+		}
+		v = w
+	}
+
+	if fraction != "" {
+		num, den, ok := strings.Cut(fraction, "/")
+		if !ok {
+			return Rational{}, ErrInvalidRational
+		}
+		n, err := strconv.ParseUint(num, 10, 63)
+		if err != nil {
+			return Rational{}, ErrInvalidRational
+		}
+		d, err := strconv.ParseUint(den, 10, 63)
+		if err != nil || d == 0 {
+			return Rational{}, ErrInvalidRational
+		}
+		if v, ok = v.add(NewRational(int64(n), int64(d))); !ok {
+			return Rational{}, ErrInvalidRational
+		}
+	}
+
+	if neg {
+		v.Num = -v.Num
+	}
+	return v, nil
+}
+
+func lastRune(s string) rune {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return r
+}
+
+// parseDecimalRational parses unsigned decimal without going through float, so 0.1 is exactly 1/10.
+func parseDecimalRational(s string) (Rational, bool) {
+	integer, fraction, _ := strings.Cut(s, ".")
+	if integer == "" && fraction == "" {
+		return Rational{}, false
+	}
+
+	digits := integer + fraction
+	if strings.TrimLeft(digits, "0123456789") != "" {
+		return Rational{}, false
+	}
+
+	num, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Rational{}, false
+	}
+
+	den := int64(1)
+	for range fraction {
+		prev := den
+		den *= 10
+		if den/10 != prev {
+			return Rational{}, false
+		}
+	}
+
+	return NewRational(num, den), true
+}
+
+func (r Rational) Float64() float64 {
+	r = r.norm()
+	return float64(r.Num) / float64(r.Den)
+}
+
+// String is mixed number, e.g. "1 1/2", "1/3", "2".
+func (r Rational) String() string {
+	b, _ := r.AppendText(nil)
+	return string(b)
+}
+
+func (r Rational) MarshalText() ([]byte, error) { return r.AppendText(nil) }
+
+func (r Rational) AppendText(b []byte) ([]byte, error) {
+	r = r.norm()
+	if r.Num < 0 {
+		b = append(b, '-')
+		r.Num = -r.Num
+	}
+
+	whole, num := r.Num/r.Den, r.Num%r.Den
+
+	if whole != 0 || num == 0 {
+		b = strconv.AppendInt(b, whole, 10)
+	}
+	if whole != 0 && num != 0 {
+		b = append(b, ' ')
+	}
+	if num != 0 {
+		b = strconv.AppendInt(b, num, 10)
+		b = append(b, '/')
+		b = strconv.AppendInt(b, r.Den, 10)
+	}
+	return b, nil
+}
+
+func (r *Rational) UnmarshalText(text []byte) error {
+	v, err := NewRationalFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+// mul multiplies with overflow check.
+func (r Rational) mul(o Rational) (Rational, bool) {
+	r, o = r.norm(), o.norm()
+
+	// cross reduce first, so that factors of ladders do not overflow needlessly
+	if g := gcd(r.Num, o.Den); g > 1 {
+		r.Num, o.Den = r.Num/g, o.Den/g
+	}
+	if g := gcd(o.Num, r.Den); g > 1 {
+		o.Num, r.Den = o.Num/g, r.Den/g
+	}
+
+	num, ok := mulInt64(r.Num, o.Num)
+	if !ok {
+		return Rational{}, false
+	}
+	den, ok := mulInt64(r.Den, o.Den)
+	if !ok {
+		return Rational{}, false
+	}
+	return NewRational(num, den), true
+}
+
+// add sums with overflow check.
+func (r Rational) add(o Rational) (Rational, bool) {
+	r, o = r.norm(), o.norm()
+
+	a, ok := mulInt64(r.Num, o.Den)
+	if !ok {
+		return Rational{}, false
+	}
+	b, ok := mulInt64(o.Num, r.Den)
+	if !ok {
+		return Rational{}, false
+	}
+	den, ok := mulInt64(r.Den, o.Den)
+	if !ok {
+		return Rational{}, false
+	}
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return Rational{}, false
+	}
+	return NewRational(a+b, den), true
+}
+
+func mulInt64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	v := a * b
+	if v/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return v, true
+}
+
+// NearestRational is closest fraction to x with one of given denominators, e.g. halves, thirds, quarters and eighths.
+// When no denominators given, 2, 3, 4 and 8 are used as in cooking measures.
+func NearestRational(x float64, denominators ...int64) Rational {
+	if len(denominators) == 0 {
+		denominators = []int64{2, 3, 4, 8}
+	}
+
+	best, bestErr := NewRational(int64(math.Round(x)), 1), math.Abs(x-math.Round(x))
+	for _, d := range denominators {
+		if d <= 0 {
+			continue
+		}
+		r := NewRational(int64(math.Round(x*float64(d))), d)
+		if e := math.Abs(x - r.Float64()); e < bestErr {
+			best, bestErr = r, e
+		}
+	}
+	return best
+}
@@ -0,0 +1,233 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleNewVolumeRationalFromString() {
+	v, _ := NewVolumeRationalFromString("1/3cup")
+	tsp, _ := v.Convert(UnitTeaspoons)
+	fmt.Println(v, tsp)
+	// Output: 1/3cup 16tsp
+}
+
+func TestNewRationalFromString(t *testing.T) {
+	tests := map[string]Rational{
+		"3":       {3, 1},
+		"0.25":    {1, 4},
+		"0.1":     {1, 10},
+		".5":      {1, 2},
+		"1/2":     {1, 2},
+		"2/4":     {1, 2},
+		"1 1/2":   {3, 2},
+		"1  1/2":  {3, 2},
+		"½":       {1, 2},
+		"1½":      {3, 2},
+		"1 ½":     {3, 2},
+		"2⅔":      {8, 3},
+		"1⁄3":     {1, 3},
+		"-1 1/2":  {-3, 2},
+		"12/8":    {3, 2},
+		"0":       {0, 1},
+		" 3/4 ":   {3, 4},
+		"10 3/16": {163, 16},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			r, err := NewRationalFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if r != v {
+				t.Error(r, v)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		for _, s := range []string{"", "-", "1/0", "a/2", "1/", "/2", "1.2.3", "1 2", "1/2/3", "½½"} {
+			if _, err := NewRationalFromString(s); !errors.Is(err, ErrInvalidRational) {
+				t.Error(s, err)
+			}
+		}
+	})
+}
+
+func TestRational_String(t *testing.T) {
+	tests := map[Rational]string{
+		{3, 1}:   "3",
+		{1, 2}:   "1/2",
+		{3, 2}:   "1 1/2",
+		{-3, 2}:  "-1 1/2",
+		{0, 1}:   "0",
+		{6, 4}:   "1 1/2",
+		{1, -3}:  "-1/3",
+		{16, 16}: "1",
+	}
+	for r, s := range tests {
+		if r.String() != s {
+			t.Error(r, r.String(), s)
+		}
+	}
+}
+
+func TestNearestRational(t *testing.T) {
+	tests := []struct {
+		x            float64
+		denominators []int64
+		v            Rational
+	}{
+		{0.5, nil, Rational{1, 2}},
+		{0.33, nil, Rational{1, 3}},
+		{0.66, nil, Rational{2, 3}},
+		{1.13, nil, Rational{9, 8}},
+		{1.1, []int64{2, 4}, Rational{1, 1}},
+		{2.0, nil, Rational{2, 1}},
+		{0.2, []int64{10}, Rational{1, 5}},
+		{0.4731762648307425, []int64{2, 4}, Rational{1, 2}},
+	}
+	for _, tc := range tests {
+		if v := NearestRational(tc.x, tc.denominators...); v != tc.v {
+			t.Error(tc.x, v, tc.v)
+		}
+	}
+}
+
+func TestRational_Equal(t *testing.T) {
+	if !(Rational{2, 4}).Equal(Rational{1, 2}) || !(Rational{1, -2}).Equal(Rational{-1, 2}) || (Rational{1, 3}).Equal(Rational{1, 2}) {
+		t.Error("must compare values")
+	}
+}
+
+func TestTryConvertExactVolumeRational(t *testing.T) {
+	type V struct {
+		amount Rational
+		unit   UnitVolume
+	}
+
+	tests := [][2]V{
+		{{Rational{1, 3}, UnitCups}, {Rational{16, 1}, UnitTeaspoons}},
+		{{Rational{1, 3}, UnitCups}, {Rational{8, 3}, UnitFluidOunces}},
+		{{Rational{1, 2}, UnitGallons}, {Rational{8, 1}, UnitCups}},
+		{{Rational{1, 1}, UnitTeaspoons}, {Rational{1, 768}, UnitGallons}},
+		{{Rational{2, 3}, UnitImperialPints}, {Rational{1, 12}, UnitImperialGallons}},
+		{{Rational{1, 3}, UnitLiters}, {Rational{1000, 3}, UnitMilliLiters}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if v, ok := TryConvertExactVolumeRational(a.amount, a.unit, b.unit); !ok || v != b.amount {
+			t.Error(a, b, v, ok)
+		}
+		if v, ok := TryConvertExactVolumeRational(b.amount, b.unit, a.unit); !ok || v != a.amount {
+			t.Error(b, a, v, ok)
+		}
+	}
+
+	t.Run("when cross system, then not exact", func(t *testing.T) {
+		if _, ok := TryConvertExactVolumeRational(Rational{1, 2}, UnitCups, UnitMilliLiters); ok {
+			t.Error("must not be exact")
+		}
+	})
+}
+
+func TestVolumeRational(t *testing.T) {
+	tests := map[string]VolumeRational{
+		"1/2cup":     {Rational{1, 2}, UnitCups},
+		"1 1/2 cup":  {Rational{3, 2}, UnitCups},
+		"½tsp":       {Rational{1, 2}, UnitTeaspoons},
+		"1½ tbsp":    {Rational{3, 2}, UnitTablespoons},
+		"2 ⅔ impgal": {Rational{8, 3}, UnitImperialGallons},
+		"1 1/2 cups": {Rational{3, 2}, UnitCups},
+		"¾ Teaspoon": {Rational{3, 4}, UnitTeaspoons},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewVolumeRationalFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *u != v {
+				t.Error(*u, v)
+			}
+		})
+	}
+
+	t.Run("when unknown unit, then error", func(t *testing.T) {
+		if _, err := NewVolumeRationalFromString("1/2 buckets"); !errors.Is(err, ErrInvalidVolumeUnit) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("when parser without aliases, then canonical symbols only", func(t *testing.T) {
+		if _, err := (Parser{}).ParseVolumeRational("1/2 cups"); !errors.Is(err, ErrInvalidVolumeUnit) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		v := VolumeRational{Rational{3, 2}, UnitCups}
+		b, err := json.Marshal(v)
+		if err != nil || string(b) != `{"amount":"1 1/2","unit":"cup"}` {
+			t.Fatal(string(b), err)
+		}
+		var d VolumeRational
+		if err := json.Unmarshal(b, &d); err != nil || d != v {
+			t.Error(d, err)
+		}
+	})
+
+	t.Run("when zero value, then zero", func(t *testing.T) {
+		b, err := json.Marshal(VolumeRational{})
+		if err != nil || string(b) != `{"amount":"0","unit":""}` {
+			t.Error(string(b), err)
+		}
+		if v := (Rational{}); v.Float64() != 0 || v.String() != "0" || !v.Equal(Rational{0, 1}) || v.Equal(Rational{1, 2}) {
+			t.Error(v)
+		}
+		if v, ok := TryConvertExactVolumeRational(Rational{}, UnitCups, UnitTeaspoons); !ok || !v.Equal(Rational{}) {
+			t.Error(v, ok)
+		}
+	})
+
+	t.Run("volume", func(t *testing.T) {
+		if v := (VolumeRational{Rational{3, 4}, UnitCups}).Volume(); v != (Volume{0.75, UnitCups}) {
+			t.Error(v)
+		}
+	})
+}
+
+func TestVolume_StringFraction(t *testing.T) {
+	tests := []struct {
+		v            Volume
+		denominators []int64
+		s            string
+	}{
+		{Volume{1.5, UnitCups}, nil, "1 1/2cup"},
+		{Volume{0.33, UnitCups}, nil, "1/3cup"},
+		{Volume{0.3, UnitCups}, []int64{2, 4}, "1/4cup"},
+		{Volume{2, UnitTeaspoons}, nil, "2tsp"},
+	}
+	for _, tc := range tests {
+		if s := tc.v.StringFraction(tc.denominators...); s != tc.s {
+			t.Error(s, tc.s)
+		}
+	}
+}
+
+func TestParser_Fractions(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish}, Lenient: true}
+	tests := map[string]Volume{
+		"1/2cup":     {0.5, UnitCups},
+		"1 1/2 cups": {1.5, UnitCups},
+		"½ tsp":      {0.5, UnitTeaspoons},
+		"1¾ gallons": {1.75, UnitGallons},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseVolume(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidSpeedAmount = errors.New("invalid speed amount")
+	ErrInvalidSpeedUnit   = errors.New("invalid speed unit")
+)
+
+// Speed is distance per time, e.g. e-bike "25km/h" or vessel "30kn".
+type Speed struct {
+	Amount float64   `json:"amount"`
+	Unit   UnitSpeed `json:"unit"`
+}
+
+func NewSpeedFromString(s string) (*Speed, error) {
+	s = normalizeUnicode(s)
+
+	var unit UnitSpeed
+	var maxl int
+	for _, u := range UnitSpeedAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitSpeedUnknown {
+		return nil, ErrInvalidSpeedUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidSpeedAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Speed{Amount: amount, Unit: unit}, nil
+}
+
+func (s Speed) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
+
+func (s *Speed) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+// Convert is exact to precision of float, all speed units are exact ratios of length and time units.
+func (s Speed) Convert(unit UnitSpeed) Speed {
+	if v, ok := TryConvertExactSpeed(s.Amount, s.Unit, unit); ok {
+		return Speed{Amount: v, Unit: unit}
+	}
+	f, _ := unitSpeedFactor(s.Unit, unit)
+	return Speed{Amount: s.Amount * float64(f.Num) / float64(f.Den), Unit: unit}
+}
+
+// Add sums speeds in unit of s.
+func (s Speed) Add(o Speed) Speed {
+	return Speed{Amount: s.Amount + o.Convert(s.Unit).Amount, Unit: s.Unit}
+}
+
+// Sub subtracts speeds in unit of s.
+func (s Speed) Sub(o Speed) Speed { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number.
+func (s Speed) Scale(k float64) Speed { return Speed{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 100km/h / 25km/h is 4.
+func (s Speed) Div(o Speed) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
+// TryConvertExactSpeed converts by factor made of length and time factors, e.g. 36km/h is exactly 10m/s.
+func TryConvertExactSpeed[T int32 | int64 | float32 | float64](amount T, from, to UnitSpeed) (v T, ok bool) {
+	f, ok := unitSpeedFactor(from, to)
+	if !ok {
+		return 0, false
+	}
+	return convertByRational(amount, f)
+}
+
+// unitSpeedFactor is how many `to` units are in one `from` unit, km/h to m/s is 1000/3600 = 5/18.
+func unitSpeedFactor(from, to UnitSpeed) (Rational, bool) {
+	a, ok := unitSpeedRatios[from]
+	if !ok {
+		return Rational{}, false
+	}
+	b, ok := unitSpeedRatios[to]
+	if !ok {
+		return Rational{}, false
+	}
+
+	l, ok := exactFactor(a.length, b.length, unitLengthLadders[:], unitLengthBridges[:])
+	if !ok {
+		return Rational{}, false
+	}
+	t, ok := exactFactor(a.time, b.time, unitTimeLadders[:], nil)
+	if !ok {
+		return Rational{}, false
+	}
+	return l.mul(NewRational(t.Den, t.Num))
+}
+
+type UnitSpeed uint8
+
+//go:generate go-enum-encoding -type=UnitSpeed -string
+const (
+	UnitSpeedUnknown      UnitSpeed = iota // json:""
+	UnitMetersPerSecond                    // json:"m/s"
+	UnitKiloMetersPerHour                  // json:"km/h"
+	UnitMilesPerHour                       // json:"mph"
+	UnitKnots                              // json:"kn"
+	UnitFeetPerSecond                      // json:"ft/s"
+)
+
+// Ratio is length and time units that define speed unit, knot is nautical mile per hour.
+func (s UnitSpeed) Ratio() (UnitLength, UnitTime) {
+	r := unitSpeedRatios[s]
+	return r.length, r.time
+}
+
+var UnitSpeedAll = [...]UnitSpeed{
+	UnitMetersPerSecond,
+	UnitKiloMetersPerHour,
+	UnitMilesPerHour,
+	UnitKnots,
+	UnitFeetPerSecond,
+}
+
+type speedRatio struct {
+	length UnitLength
+	time   UnitTime
+}
+
+var unitSpeedRatios = map[UnitSpeed]speedRatio{
+	UnitMetersPerSecond:   {UnitMeters, UnitSeconds},
+	UnitKiloMetersPerHour: {UnitKiloMeters, UnitHours},
+	UnitMilesPerHour:      {UnitMiles, UnitHours},
+	UnitKnots:             {UnitNauticalMiles, UnitHours},
+	UnitFeetPerSecond:     {UnitFeet, UnitSeconds},
+}
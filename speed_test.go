@@ -0,0 +1,133 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleNewSpeedFromString() {
+	v, _ := NewSpeedFromString("36km/h")
+	fmt.Println(v.Amount, v.Unit, v.Convert(UnitMetersPerSecond))
+	// Output: 36 km/h 10m/s
+}
+
+func TestSpeed(t *testing.T) {
+	tests := map[string]Speed{
+		"25km/h": {Amount: 25, Unit: UnitKiloMetersPerHour},
+		"15mph":  {Amount: 15, Unit: UnitMilesPerHour},
+		"30kn":   {Amount: 30, Unit: UnitKnots},
+		"9.8m/s": {Amount: 9.8, Unit: UnitMetersPerSecond},
+		"12ft/s": {Amount: 12, Unit: UnitFeetPerSecond},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewSpeedFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("when space before unit, then same", func(t *testing.T) {
+		if u, err := NewSpeedFromString("25 km/h"); err != nil || *u != (Speed{25, UnitKiloMetersPerHour}) {
+			t.Error(u, err)
+		}
+	})
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewSpeedFromString("5"); !errors.Is(err, ErrInvalidSpeedUnit) {
+			t.Error(err)
+		}
+		if _, err := NewSpeedFromString("mph"); !errors.Is(err, ErrInvalidSpeedAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Speed{Amount: 2, Unit: UnitKiloMetersPerHour})
+		if err != nil || string(b) != `{"amount":2,"unit":"km/h"}` {
+			t.Error(string(b), err)
+		}
+		var v Speed
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"kn"}`), &v); err != nil || v != (Speed{3, UnitKnots}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestSpeedConversion(t *testing.T) {
+	tests := [][2]Speed{
+		{{36, UnitKiloMetersPerHour}, {10, UnitMetersPerSecond}},
+		{{1, UnitMilesPerHour}, {1.609344, UnitKiloMetersPerHour}},
+		{{1, UnitKnots}, {1.852, UnitKiloMetersPerHour}},
+		{{30, UnitKnots}, {55.56, UnitKiloMetersPerHour}},
+		{{15, UnitMilesPerHour}, {22, UnitFeetPerSecond}},
+		{{1, UnitFeetPerSecond}, {0.3048, UnitMetersPerSecond}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-12 || c.Unit != b.Unit {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-12 || c.Unit != a.Unit {
+			t.Error(c, a)
+		}
+	}
+}
+
+func TestTryConvertExactSpeed(t *testing.T) {
+	if f, ok := unitSpeedFactor(UnitKiloMetersPerHour, UnitMetersPerSecond); !ok || f != (Rational{5, 18}) {
+		t.Error(f, ok)
+	}
+	if f, ok := unitSpeedFactor(UnitKnots, UnitMetersPerSecond); !ok || f != (Rational{463, 900}) {
+		t.Error(f, ok)
+	}
+	if v, ok := TryConvertExactSpeed[int64](90, UnitKiloMetersPerHour, UnitMetersPerSecond); !ok || v != 25 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactSpeed[int64](10, UnitKiloMetersPerHour, UnitMetersPerSecond); ok {
+		t.Error("25/9 m/s is not whole", v)
+	}
+	if v, ok := TryConvertExactSpeed[int64](1, UnitSpeedUnknown, UnitMetersPerSecond); ok {
+		t.Error(v)
+	}
+}
+
+func TestUnitSpeed_Ratio(t *testing.T) {
+	if l, d := UnitKnots.Ratio(); l != UnitNauticalMiles || d != UnitHours {
+		t.Error(l, d)
+	}
+}
+
+func TestSpeed_Add(t *testing.T) {
+	if v := (Speed{10, UnitMetersPerSecond}).Add(Speed{36, UnitKiloMetersPerHour}); v != (Speed{20, UnitMetersPerSecond}) {
+		t.Error(v)
+	}
+}
+
+func TestParser_ParseSpeed(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Speed{
+		"25 km/h":  {25, UnitKiloMetersPerHour},
+		"15mph":    {15, UnitMilesPerHour},
+		"30 kn":    {30, UnitKnots},
+		"30 knots": {30, UnitKnots},
+		"60 км/ч":  {60, UnitKiloMetersPerHour},
+		"100 KPH":  {100, UnitKiloMetersPerHour},
+		"3,5 м/с":  {3.5, UnitMetersPerSecond},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseSpeed(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
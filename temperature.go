@@ -0,0 +1,202 @@
+package measurement
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidTemperatureAmount = errors.New("invalid temperature amount")
+	ErrInvalidTemperatureUnit   = errors.New("invalid temperature unit")
+)
+
+// Temperature is absolute temperature, e.g. "-18C" storage or "350F" oven.
+// Scales have offsets, so absolute temperatures are not added, difference of them is TemperatureDelta.
+type Temperature struct {
+	Amount float64         `json:"amount"`
+	Unit   UnitTemperature `json:"unit"`
+}
+
+// NewTemperatureFromString parses "-18C", "-18°C", "350 F" and "℃" forms.
+func NewTemperatureFromString(s string) (*Temperature, error) {
+	amount, unit, err := parseTemperature(s)
+	if err != nil {
+		return nil, err
+	}
+	return newTemperature(amount, unit)
+}
+
+func newTemperature(amount float64, unit UnitTemperature) (*Temperature, error) {
+	v := Temperature{Amount: amount, Unit: unit}
+	if v.Kelvin() < 0 {
+		return nil, ErrInvalidTemperatureAmount // below absolute zero
+	}
+	return &v, nil
+}
+
+func parseTemperature(s string) (float64, UnitTemperature, error) {
+	s = strings.TrimSpace(trimDegree(normalizeUnicode(s)))
+
+	var unit UnitTemperature
+	var maxl int
+	for _, u := range UnitTemperatureAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitTemperatureUnknown {
+		return 0, unit, ErrInvalidTemperatureUnit
+	}
+
+	lenAmount := len(s) - len(unit.String())
+	if lenAmount <= 0 {
+		return 0, unit, ErrInvalidTemperatureAmount
+	}
+
+	amount, err := strconv.ParseFloat(strings.TrimSpace(s[:lenAmount]), 64)
+	if err != nil {
+		return 0, unit, ErrInvalidTemperatureAmount
+	}
+
+	return amount, unit, nil
+}
+
+// trimDegree removes degree sign before unit, "°C" is "C".
+func trimDegree(s string) string { return strings.NewReplacer("°", "", "º", "").Replace(s) }
+
+func (s Temperature) String() string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String()
+}
+
+func (s Temperature) StringStyle(style SymbolStyle) string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.Symbol(style)
+}
+
+// IsZero is true for missing temperature only, 0C is valid temperature.
+func (s *Temperature) IsZero() bool { return s == nil || s.Unit == UnitTemperatureUnknown }
+
+func (s Temperature) Convert(unit UnitTemperature) Temperature {
+	if s.Unit == unit {
+		return s
+	}
+	return Temperature{Amount: fromCelsius(toCelsius(s.Amount, s.Unit), unit), Unit: unit}
+}
+
+func (s Temperature) Kelvin() float64 { return s.Convert(UnitKelvin).Amount }
+
+// Sub is difference of temperatures in unit of s.
+func (s Temperature) Sub(o Temperature) TemperatureDelta {
+	return TemperatureDelta{Amount: s.Amount - o.Convert(s.Unit).Amount, Unit: s.Unit}
+}
+
+// Add shifts temperature by difference.
+func (s Temperature) Add(d TemperatureDelta) Temperature {
+	return Temperature{Amount: s.Amount + d.Convert(s.Unit).Amount, Unit: s.Unit}
+}
+
+// zeros of Celsius and Fahrenheit scales above absolute zero, in their degrees.
+const (
+	celsiusZeroKelvin     = 273.15
+	fahrenheitZeroRankine = 459.67
+)
+
+func toCelsius(amount float64, unit UnitTemperature) float64 {
+	switch unit {
+	case UnitFahrenheit:
+		return (amount - 32) * 5 / 9
+	case UnitKelvin:
+		return amount - celsiusZeroKelvin
+	case UnitRankine:
+		return (amount - fahrenheitZeroRankine - 32) * 5 / 9
+	default:
+		return amount
+	}
+}
+
+func fromCelsius(amount float64, unit UnitTemperature) float64 {
+	switch unit {
+	case UnitFahrenheit:
+		return amount*9/5 + 32
+	case UnitKelvin:
+		return amount + celsiusZeroKelvin
+	case UnitRankine:
+		return amount*9/5 + 32 + fahrenheitZeroRankine
+	default:
+		return amount
+	}
+}
+
+// TemperatureDelta is difference of temperatures, it scales without offset, so 1K = 1C = 1.8F.
+type TemperatureDelta struct {
+	Amount float64         `json:"amount"`
+	Unit   UnitTemperature `json:"unit"`
+}
+
+func NewTemperatureDeltaFromString(s string) (*TemperatureDelta, error) {
+	amount, unit, err := parseTemperature(s)
+	if err != nil {
+		return nil, err
+	}
+	return &TemperatureDelta{Amount: amount, Unit: unit}, nil
+}
+
+func (s TemperatureDelta) String() string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String()
+}
+
+func (s *TemperatureDelta) IsZero() bool { return s == nil || s.Amount == 0 }
+
+func (s TemperatureDelta) Convert(unit UnitTemperature) TemperatureDelta {
+	if s.Unit == unit {
+		return s
+	}
+	return TemperatureDelta{Amount: s.Amount * deltaCelsius(s.Unit) / deltaCelsius(unit), Unit: unit}
+}
+
+func (s TemperatureDelta) Add(o TemperatureDelta) TemperatureDelta {
+	return TemperatureDelta{Amount: s.Amount + o.Convert(s.Unit).Amount, Unit: s.Unit}
+}
+
+func (s TemperatureDelta) Sub(o TemperatureDelta) TemperatureDelta { return s.Add(o.Scale(-1)) }
+
+func (s TemperatureDelta) Scale(k float64) TemperatureDelta {
+	return TemperatureDelta{Amount: s.Amount * k, Unit: s.Unit}
+}
+
+// deltaCelsius is size of degree in degrees Celsius.
+func deltaCelsius(unit UnitTemperature) float64 {
+	switch unit {
+	case UnitFahrenheit, UnitRankine:
+		return 5.0 / 9
+	default:
+		return 1
+	}
+}
+
+type UnitTemperature uint8
+
+//go:generate go-enum-encoding -type=UnitTemperature -string
+const (
+	UnitTemperatureUnknown UnitTemperature = iota // json:""
+	UnitCelsius                                   // json:"C"
+	UnitFahrenheit                                // json:"F"
+	UnitKelvin                                    // json:"K"
+	UnitRankine                                   // json:"R"
+)
+
+func (s UnitTemperature) Symbol(style SymbolStyle) string {
+	if v, ok := unicodeSymbolsTemperature[s]; ok && style == SymbolStyleUnicode {
+		return v
+	}
+	return s.String()
+}
+
+var UnitTemperatureAll = [...]UnitTemperature{
+	UnitCelsius,
+	UnitFahrenheit,
+	UnitKelvin,
+	UnitRankine,
+}
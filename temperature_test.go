@@ -0,0 +1,154 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleNewTemperatureFromString() {
+	v, _ := NewTemperatureFromString("350 F")
+	fmt.Println(v, v.Convert(UnitCelsius).Amount > 176, v.StringStyle(SymbolStyleUnicode))
+	// Output: 350F true 350°F
+}
+
+func TestTemperature(t *testing.T) {
+	tests := map[string]Temperature{
+		"-18°C":   {-18, UnitCelsius},
+		"-18C":    {-18, UnitCelsius},
+		"-18 °C":  {-18, UnitCelsius},
+		"350 F":   {350, UnitFahrenheit},
+		"350°F":   {350, UnitFahrenheit},
+		"350℉":    {350, UnitFahrenheit},
+		"4℃":      {4, UnitCelsius},
+		"0K":      {0, UnitKelvin},
+		"300 K":   {300, UnitKelvin},
+		"491.67R": {491.67, UnitRankine},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewTemperatureFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *u != v {
+				t.Error(u, v)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		tests := map[string]error{
+			"18":       ErrInvalidTemperatureUnit,
+			"°C":       ErrInvalidTemperatureAmount,
+			"x C":      ErrInvalidTemperatureAmount,
+			"-300C":    ErrInvalidTemperatureAmount,
+			"-1K":      ErrInvalidTemperatureAmount,
+			"-460F":    ErrInvalidTemperatureAmount,
+			"18 grams": ErrInvalidTemperatureUnit,
+		}
+		for s, e := range tests {
+			if _, err := NewTemperatureFromString(s); !errors.Is(err, e) {
+				t.Error(s, err)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Temperature{Amount: -18, Unit: UnitCelsius})
+		if err != nil || string(b) != `{"amount":-18,"unit":"C"}` {
+			t.Error(string(b), err)
+		}
+		var v Temperature
+		if err := json.Unmarshal([]byte(`{"amount":350,"unit":"F"}`), &v); err != nil || v != (Temperature{350, UnitFahrenheit}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestTemperatureConversion(t *testing.T) {
+	tests := [][2]Temperature{
+		{{0, UnitCelsius}, {32, UnitFahrenheit}},
+		{{100, UnitCelsius}, {212, UnitFahrenheit}},
+		{{-40, UnitCelsius}, {-40, UnitFahrenheit}},
+		{{0, UnitCelsius}, {273.15, UnitKelvin}},
+		{{0, UnitKelvin}, {-459.67, UnitFahrenheit}},
+		{{0, UnitKelvin}, {0, UnitRankine}},
+		{{32, UnitFahrenheit}, {491.67, UnitRankine}},
+		{{-18, UnitCelsius}, {-0.4, UnitFahrenheit}},
+		{{300, UnitKelvin}, {540, UnitRankine}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); math.Abs(c.Amount-b.Amount) > 1e-9 || c.Unit != b.Unit {
+			t.Error(a, c, b)
+		}
+		if c := b.Convert(a.Unit); math.Abs(c.Amount-a.Amount) > 1e-9 || c.Unit != a.Unit {
+			t.Error(b, c, a)
+		}
+	}
+}
+
+func TestTemperatureDelta(t *testing.T) {
+	t.Run("difference of temperatures", func(t *testing.T) {
+		d := Temperature{25, UnitCelsius}.Sub(Temperature{50, UnitFahrenheit})
+		if d.Unit != UnitCelsius || math.Abs(d.Amount-15) > 1e-9 {
+			t.Error(d)
+		}
+		if f := d.Convert(UnitFahrenheit); math.Abs(f.Amount-27) > 1e-9 {
+			t.Error(f)
+		}
+		if k := d.Convert(UnitKelvin); math.Abs(k.Amount-15) > 1e-9 {
+			t.Error(k)
+		}
+	})
+
+	t.Run("shift by difference", func(t *testing.T) {
+		v := Temperature{20, UnitCelsius}.Add(TemperatureDelta{9, UnitFahrenheit})
+		if v.Unit != UnitCelsius || math.Abs(v.Amount-25) > 1e-9 {
+			t.Error(v)
+		}
+	})
+
+	t.Run("differences add", func(t *testing.T) {
+		d := TemperatureDelta{1, UnitKelvin}.Add(TemperatureDelta{1.8, UnitFahrenheit}).Scale(2)
+		if d.Unit != UnitKelvin || math.Abs(d.Amount-4) > 1e-9 {
+			t.Error(d)
+		}
+	})
+
+	t.Run("when absolute temperatures added, then dimension mismatch", func(t *testing.T) {
+		a, b := Temperature{20, UnitCelsius}, Temperature{30, UnitCelsius}
+		if _, ok := (Quantity{Type: MeasureTypeTemperature, Temperature: &a}).add(Quantity{Type: MeasureTypeTemperature, Temperature: &b}); ok {
+			t.Error("absolute temperatures must not add")
+		}
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		if d, err := NewTemperatureDeltaFromString("-5K"); err != nil || *d != (TemperatureDelta{-5, UnitKelvin}) {
+			t.Error(d, err)
+		}
+	})
+}
+
+func TestParser_ParseTemperature(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Temperature{
+		"350 degrees F":       {350, UnitFahrenheit},
+		"180 degrees celsius": {180, UnitCelsius},
+		"-18 °C":              {-18, UnitCelsius},
+		"-18 °С":              {-18, UnitCelsius},
+		"4 deg. c":            {4, UnitCelsius},
+		"77 K":                {77, UnitKelvin},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseTemperature(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+	if _, err := p.ParseTemperature("-500 C"); !errors.Is(err, ErrInvalidTemperatureAmount) {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,228 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidTimeAmount = errors.New("invalid time amount")
+	ErrInvalidTimeUnit   = errors.New("invalid time unit")
+)
+
+// Time is span of time, e.g. shelf life "90d" or interval "2wk".
+// Units up to weeks are exact, months and years are average Gregorian ones and approximate.
+type Time struct {
+	Amount float64  `json:"amount"`
+	Unit   UnitTime `json:"unit"`
+}
+
+// normalizedMicroSeconds is how µs reads after normalizeUnicode, canonical symbol is "us".
+var normalizedMicroSeconds = normalizeUnicode(UnitMicroSeconds.Symbol(SymbolStyleUnicode))
+
+func NewTimeFromString(s string) (*Time, error) {
+	s = normalizeUnicode(s)
+	if v, ok := strings.CutSuffix(s, normalizedMicroSeconds); ok {
+		s = v + UnitMicroSeconds.String()
+	}
+
+	var unit UnitTime
+	var maxl int
+	for _, u := range UnitTimeAll {
+		if strings.HasSuffix(s, u.String()) && len(u.String()) > maxl {
+			unit = u
+			maxl = len(u.String())
+		}
+	}
+
+	if unit == UnitTimeUnknown {
+		return nil, ErrInvalidTimeUnit
+	}
+
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
+		return nil, ErrInvalidTimeAmount
+	}
+
+	amount, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Time{Amount: amount, Unit: unit}, nil
+}
+
+// NewTimeFromDuration is duration in largest unit up to weeks that holds it whole, so 36h is "36h" and 14 days is "2wk".
+func NewTimeFromDuration(d time.Duration) Time {
+	if d == 0 {
+		return Time{Unit: UnitSeconds}
+	}
+	for i := len(unitTimeLadder) - 1; i > 0; i-- {
+		if v, ok := convertByLadder(int64(d), UnitNanoSeconds, unitTimeLadder[i].unit, unitTimeLadder); ok {
+			return Time{Amount: float64(v), Unit: unitTimeLadder[i].unit}
+		}
+	}
+	return Time{Amount: float64(d), Unit: UnitNanoSeconds}
+}
+
+func (s Time) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
+
+func (s Time) StringStyle(style SymbolStyle) string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.Symbol(style)
+}
+
+func (s *Time) IsZero() bool {
+	if s == nil {
+		return true
+	}
+	if s.Amount == 0 {
+		return true
+	}
+	return false
+}
+
+func (s Time) Convert(unit UnitTime) Time {
+	if v, ok := TryConvertExactTime(s.Amount, s.Unit, unit); ok {
+		return Time{Amount: v, Unit: unit}
+	}
+	return Time{Amount: convertTimeApproxFromDays(convertTimeApproxToDays(s.Amount, s.Unit), unit), Unit: unit}
+}
+
+// Duration is exact time.Duration, it is not ok for months and years, fractions of nanosecond and overflow.
+func (s Time) Duration() (time.Duration, bool) {
+	if s.Amount == math.Trunc(s.Amount) && math.Abs(s.Amount) < math.MaxInt64 {
+		v, ok := convertByLadder(int64(s.Amount), s.Unit, UnitNanoSeconds, unitTimeLadder)
+		if !ok {
+			return 0, false
+		}
+		return time.Duration(v), true
+	}
+
+	v, ok := convertByLadder(s.Amount, s.Unit, UnitNanoSeconds, unitTimeLadder)
+	if !ok || v != math.Trunc(v) || math.Abs(v) >= math.MaxInt64 {
+		return 0, false
+	}
+	return time.Duration(v), true
+}
+
+// Add sums times in unit of s, or in finer unit when both are in same ladder, so 1h + 30min is exactly 90min.
+func (s Time) Add(o Time) Time {
+	unit := s.Unit
+	for _, l := range unitTimeLadders {
+		if u, ok := l.finer(s.Unit, o.Unit); ok {
+			unit = u
+			break
+		}
+	}
+	return Time{Amount: s.Convert(unit).Amount + o.Convert(unit).Amount, Unit: unit}
+}
+
+// Sub subtracts times in same unit as Add.
+func (s Time) Sub(o Time) Time { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number, e.g. count of intervals.
+func (s Time) Scale(k float64) Time { return Time{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 1h / 15min is 4.
+func (s Time) Div(o Time) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
+func TryConvertExactTime[T int32 | int64 | float32 | float64](amount T, from, to UnitTime) (v T, ok bool) {
+	for _, l := range unitTimeLadders {
+		if v, ok := convertByLadder(amount, from, to, l); ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+type UnitTime uint8
+
+//go:generate go-enum-encoding -type=UnitTime -string
+const (
+	UnitTimeUnknown  UnitTime = iota // json:""
+	UnitNanoSeconds                  // json:"ns"
+	UnitMicroSeconds                 // json:"us"
+	UnitMilliSeconds                 // json:"ms"
+	UnitSeconds                      // json:"s"
+	UnitMinutes                      // json:"min"
+	UnitHours                        // json:"h"
+	UnitDays                         // json:"d"
+	UnitWeeks                        // json:"wk"
+	UnitMonths                       // json:"mo"
+	UnitYears                        // json:"yr"
+)
+
+func (s UnitTime) Symbol(style SymbolStyle) string {
+	if v, ok := unicodeSymbolsTime[s]; ok && style == SymbolStyleUnicode {
+		return v
+	}
+	return s.String()
+}
+
+var UnitTimeAll = [...]UnitTime{
+	UnitNanoSeconds,
+	UnitMicroSeconds,
+	UnitMilliSeconds,
+	UnitSeconds,
+	UnitMinutes,
+	UnitHours,
+	UnitDays,
+	UnitWeeks,
+	UnitMonths,
+	UnitYears,
+}
+
+var unitTimeLadder = ladder[UnitTime]{
+	{UnitNanoSeconds, 1},
+	{UnitMicroSeconds, 1000},
+	{UnitMilliSeconds, 1000},
+	{UnitSeconds, 1000},
+	{UnitMinutes, 60},
+	{UnitHours, 60},
+	{UnitDays, 24},
+	{UnitWeeks, 7},
+}
+
+// months and years are exact to each other, but not to days.
+var unitTimeCalendarLadder = ladder[UnitTime]{
+	{UnitMonths, 1},
+	{UnitYears, 12},
+}
+
+var unitTimeLadders = [...]ladder[UnitTime]{
+	unitTimeLadder,
+	unitTimeCalendarLadder,
+}
+
+// average Gregorian year has 365.2425 days, month is twelfth of it.
+const (
+	dayMulApproxUnitMonths = 30.436875
+	dayMulApproxUnitYears  = 365.2425
+)
+
+func convertTimeApproxToDays[T float32 | float64](amount T, unit UnitTime) T {
+	switch unit {
+	case UnitMonths:
+		return amount * dayMulApproxUnitMonths
+	case UnitYears:
+		return amount * dayMulApproxUnitYears
+	default:
+		v, _ := convertByLadderApprox(amount, unit, UnitDays, unitTimeLadder)
+		return v
+	}
+}
+
+func convertTimeApproxFromDays[T float32 | float64](amount T, unit UnitTime) T {
+	switch unit {
+	case UnitMonths:
+		return amount / dayMulApproxUnitMonths
+	case UnitYears:
+		return amount / dayMulApproxUnitYears
+	default:
+		v, _ := convertByLadderApprox(amount, UnitDays, unit, unitTimeLadder)
+		return v
+	}
+}
@@ -0,0 +1,217 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func ExampleNewTimeFromString() {
+	v, _ := NewTimeFromString("36h")
+	d, _ := v.Duration()
+	fmt.Println(v.Amount, v.Unit, v.Convert(UnitDays), d)
+	// Output: 36 h 1.5d 36h0m0s
+}
+
+func TestTime(t *testing.T) {
+	tests := map[string]Time{
+		"90d":   {Amount: 90, Unit: UnitDays},
+		"36h":   {Amount: 36, Unit: UnitHours},
+		"15min": {Amount: 15, Unit: UnitMinutes},
+		"250ms": {Amount: 250, Unit: UnitMilliSeconds},
+		"2wk":   {Amount: 2, Unit: UnitWeeks},
+		"1.5yr": {Amount: 1.5, Unit: UnitYears},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			u, err := NewTimeFromString(s)
+			if err != nil {
+				t.Error(err.Error())
+			}
+			if u == nil || *u != v {
+				t.Error(u, v)
+			}
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		if _, err := NewTimeFromString("5"); !errors.Is(err, ErrInvalidTimeUnit) {
+			t.Error(err)
+		}
+		if _, err := NewTimeFromString("min"); !errors.Is(err, ErrInvalidTimeAmount) {
+			t.Error(err)
+		}
+	})
+
+	t.Run("unicode", func(t *testing.T) {
+		if s := (Time{5, UnitMicroSeconds}).StringStyle(SymbolStyleUnicode); s != "5µs" {
+			t.Error(s)
+		}
+
+		var p Parser
+		for _, u := range UnitTimeAll {
+			m := Time{Amount: 1.5, Unit: u}
+			if v, err := p.ParseTime(m.StringStyle(SymbolStyleUnicode)); err != nil || *v != m {
+				t.Error(u, v, err)
+			}
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(Time{Amount: 2, Unit: UnitWeeks})
+		if err != nil || string(b) != `{"amount":2,"unit":"wk"}` {
+			t.Error(string(b), err)
+		}
+		var v Time
+		if err := json.Unmarshal([]byte(`{"amount":3,"unit":"mo"}`), &v); err != nil || v != (Time{3, UnitMonths}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestTimeConversion(t *testing.T) {
+	tests := [][2]Time{
+		{{1, UnitWeeks}, {168, UnitHours}},
+		{{1, UnitDays}, {86400, UnitSeconds}},
+		{{1, UnitSeconds}, {1000000000, UnitNanoSeconds}},
+		{{1, UnitYears}, {12, UnitMonths}},
+	}
+	for _, tc := range tests {
+		a, b := tc[0], tc[1]
+		if c := a.Convert(b.Unit); c != b {
+			t.Error(c, b)
+		}
+		if c := b.Convert(a.Unit); c != a {
+			t.Error(c, a)
+		}
+	}
+
+	t.Run("when months or years, then approximate", func(t *testing.T) {
+		if _, ok := TryConvertExactTime[int64](1, UnitMonths, UnitDays); ok {
+			t.Error("month is not exact in days")
+		}
+		if v := (Time{1, UnitYears}).Convert(UnitDays); v != (Time{365.2425, UnitDays}) {
+			t.Error(v)
+		}
+		if v := (Time{2, UnitWeeks}).Convert(UnitMonths); math.Abs(v.Amount-14/30.436875) > 1e-12 {
+			t.Error(v)
+		}
+	})
+
+	t.Run("when amount is not whole in ladder step, then approximate", func(t *testing.T) {
+		tests := []struct {
+			from Time
+			to   UnitTime
+			want float64
+		}{
+			{Time{11, UnitSeconds}, UnitYears, 11 / (365.2425 * 86400)},
+			{Time{0.9, UnitHours}, UnitMonths, 0.9 / (30.436875 * 24)},
+			{Time{1.5, UnitMonths}, UnitSeconds, 1.5 * 30.436875 * 86400},
+			{Time{7, UnitSeconds}, UnitHours, 7.0 / 3600},
+		}
+		for _, tc := range tests {
+			if v := tc.from.Convert(tc.to); v.Unit != tc.to || math.Abs(v.Amount-tc.want) > 1e-9*tc.want {
+				t.Error(tc.from, v, tc.want)
+			}
+		}
+	})
+}
+
+func TestTryConvertExactTime(t *testing.T) {
+	if v, ok := TryConvertExactTime[int64](90, UnitMinutes, UnitHours); ok {
+		t.Error("1.5h is not whole", v)
+	}
+	if v, ok := TryConvertExactTime[int64](3, UnitWeeks, UnitDays); !ok || v != 21 {
+		t.Error(v, ok)
+	}
+	if v, ok := TryConvertExactTime[int32](3, UnitSeconds, UnitNanoSeconds); ok {
+		t.Error("overflow", v)
+	}
+}
+
+func TestTime_Duration(t *testing.T) {
+	tests := map[Time]time.Duration{
+		{36, UnitHours}:           36 * time.Hour,
+		{1.5, UnitHours}:          90 * time.Minute,
+		{2, UnitWeeks}:            14 * 24 * time.Hour,
+		{250, UnitMilliSeconds}:   250 * time.Millisecond,
+		{-5, UnitSeconds}:         -5 * time.Second,
+		{1, UnitNanoSeconds}:      time.Nanosecond,
+		{0.001, UnitMicroSeconds}: time.Nanosecond,
+	}
+	for v, d := range tests {
+		if got, ok := v.Duration(); !ok || got != d {
+			t.Error(v, got, ok)
+		}
+	}
+
+	t.Run("when not exact, then not ok", func(t *testing.T) {
+		for _, v := range []Time{
+			{1, UnitMonths},
+			{1, UnitYears},
+			{0.5, UnitNanoSeconds},
+			{20000, UnitWeeks},
+			{1e30, UnitSeconds},
+		} {
+			if d, ok := v.Duration(); ok || d != 0 {
+				t.Error(v, d)
+			}
+		}
+	})
+
+	t.Run("from duration", func(t *testing.T) {
+		tests := map[time.Duration]Time{
+			36 * time.Hour:          {36, UnitHours},
+			14 * 24 * time.Hour:     {2, UnitWeeks},
+			90 * time.Minute:        {90, UnitMinutes},
+			1500 * time.Millisecond: {1500, UnitMilliSeconds},
+			-2 * time.Second:        {-2, UnitSeconds},
+			time.Nanosecond:         {1, UnitNanoSeconds},
+			0:                       {0, UnitSeconds},
+		}
+		for d, v := range tests {
+			if got := NewTimeFromDuration(d); got != v {
+				t.Error(d, got, v)
+			}
+			if got, ok := v.Duration(); !ok || got != d {
+				t.Error(v, got, ok)
+			}
+		}
+	})
+}
+
+func TestTime_Add(t *testing.T) {
+	if v := (Time{1, UnitHours}).Add(Time{30, UnitMinutes}); v != (Time{90, UnitMinutes}) {
+		t.Error(v)
+	}
+	if v := (Time{1, UnitYears}).Add(Time{6, UnitMonths}); v != (Time{18, UnitMonths}) {
+		t.Error(v)
+	}
+	if v := (Time{1, UnitWeeks}).Sub(Time{2, UnitDays}); v != (Time{5, UnitDays}) {
+		t.Error(v)
+	}
+}
+
+func TestParser_ParseTime(t *testing.T) {
+	p := Parser{Languages: []Language{LanguageEnglish, LanguageRussian}, Lenient: true}
+	tests := map[string]Time{
+		"2 weeks":   {2, UnitWeeks},
+		"90 days":   {90, UnitDays},
+		"1,5 часа":  {1.5, UnitHours},
+		"12 мес":    {12, UnitMonths},
+		"30 Sec":    {30, UnitSeconds},
+		"5 µs":      {5, UnitMicroSeconds},
+		"1 ½ hours": {1.5, UnitHours},
+	}
+	for s, v := range tests {
+		if u, err := p.ParseTime(s); err != nil || *u != v {
+			t.Error(s, u, err)
+		}
+	}
+}
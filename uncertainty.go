@@ -0,0 +1,294 @@
+package measurement
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidUncertainty = errors.New("invalid uncertainty")
+
+// UncertainMass is mass with absolute uncertainty in same unit ("5±0.1kg"),
+// or relative uncertainty as fraction of amount ("5kg±2%").
+// Arithmetic propagates uncertainty by first order rules for independent values.
+type UncertainMass struct {
+	Amount      float64  `json:"amount"`
+	Unit        UnitMass `json:"unit"`
+	Uncertainty float64  `json:"uncertainty"`
+	Relative    bool     `json:"relative,omitzero"`
+}
+
+func NewUncertainMassFromString(s string) (*UncertainMass, error) {
+	return Parser{}.ParseUncertainMass(s)
+}
+
+// ParseUncertainMass parses "5.0 ± 0.1 kg", "5.0 +/- 0.1 kg", "(5.0 ± 0.1) kg", "5 kg ± 100 g" and "5 kg ± 2%".
+func (p Parser) ParseUncertainMass(s string) (*UncertainMass, error) {
+	a, b, ok := splitUncertainty(s)
+	if !ok {
+		return nil, ErrInvalidUncertainty
+	}
+
+	if u, ok := strings.CutSuffix(b, "%"); ok {
+		m, err := p.ParseMass(a)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := parseAmount(strings.TrimSpace(u), p.formats(), p.Grouping)
+		if !ok || v < 0 {
+			return nil, ErrInvalidUncertainty
+		}
+		return &UncertainMass{Amount: m.Amount, Unit: m.Unit, Uncertainty: v / 100, Relative: true}, nil
+	}
+
+	m, errA := p.ParseMass(a)
+	u, errB := p.ParseMass(b)
+
+	switch {
+	case errA == nil && errB == nil:
+		u.Amount = u.Convert(m.Unit).Amount
+	case errA == nil && errors.Is(errB, ErrInvalidMassUnit):
+		v, ok := parseAmount(b, p.formats(), p.Grouping)
+		if !ok {
+			return nil, ErrInvalidUncertainty
+		}
+		u = &Mass{Amount: v, Unit: m.Unit}
+	case errors.Is(errA, ErrInvalidMassUnit) && errB == nil:
+		v, ok := parseAmount(a, p.formats(), p.Grouping)
+		if !ok {
+			return nil, ErrInvalidMassAmount
+		}
+		m = &Mass{Amount: v, Unit: u.Unit}
+	case errA != nil:
+		return nil, errA
+	default:
+		return nil, errB
+	}
+
+	if u.Amount < 0 {
+		return nil, ErrInvalidUncertainty
+	}
+	return &UncertainMass{Amount: m.Amount, Unit: m.Unit, Uncertainty: u.Amount}, nil
+}
+
+func (s UncertainMass) String() string {
+	if s.Relative {
+		return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() + "±" + formatPercent(s.Uncertainty) + "%"
+	}
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + "±" + strconv.FormatFloat(s.Uncertainty, 'f', -1, 64) + s.Unit.String()
+}
+
+func (s UncertainMass) Mass() Mass { return Mass{Amount: s.Amount, Unit: s.Unit} }
+
+// AbsoluteUncertainty is uncertainty in unit of mass.
+func (s UncertainMass) AbsoluteUncertainty() float64 {
+	if s.Relative {
+		return math.Abs(s.Amount) * s.Uncertainty
+	}
+	return s.Uncertainty
+}
+
+// RelativeUncertainty is uncertainty as fraction of amount.
+func (s UncertainMass) RelativeUncertainty() float64 {
+	if s.Relative {
+		return s.Uncertainty
+	}
+	return s.Uncertainty / math.Abs(s.Amount)
+}
+
+// withAbsolute makes value of same kind (absolute or relative) as s.
+func (s UncertainMass) withAbsolute(amount, uncertainty float64) UncertainMass {
+	v := UncertainMass{Amount: amount, Unit: s.Unit, Uncertainty: uncertainty, Relative: s.Relative}
+	if s.Relative {
+		v.Uncertainty = uncertainty / math.Abs(amount)
+	}
+	return v
+}
+
+// Convert scales absolute uncertainty together with amount, relative uncertainty does not change.
+func (s UncertainMass) Convert(unit UnitMass) UncertainMass {
+	v := UncertainMass{Amount: s.Mass().Convert(unit).Amount, Unit: unit, Uncertainty: s.Uncertainty, Relative: s.Relative}
+	if !s.Relative {
+		v.Uncertainty = Mass{Amount: s.Uncertainty, Unit: s.Unit}.Convert(unit).Amount
+	}
+	return v
+}
+
+// Add sums amounts and adds absolute uncertainties in quadrature.
+func (s UncertainMass) Add(o UncertainMass) UncertainMass {
+	o = o.Convert(s.Unit)
+	return s.withAbsolute(s.Amount+o.Amount, math.Hypot(s.AbsoluteUncertainty(), o.AbsoluteUncertainty()))
+}
+
+// Sub subtracts amounts and adds absolute uncertainties in quadrature.
+func (s UncertainMass) Sub(o UncertainMass) UncertainMass {
+	o = o.Convert(s.Unit)
+	return s.withAbsolute(s.Amount-o.Amount, math.Hypot(s.AbsoluteUncertainty(), o.AbsoluteUncertainty()))
+}
+
+// Scale multiplies by exact factor, e.g. count of items.
+func (s UncertainMass) Scale(k float64) UncertainMass {
+	return s.withAbsolute(s.Amount*k, s.AbsoluteUncertainty()*math.Abs(k))
+}
+
+// Div is ratio of masses and its absolute uncertainty, relative uncertainties add in quadrature.
+func (s UncertainMass) Div(o UncertainMass) (v, uncertainty float64) {
+	c := o.Convert(s.Unit)
+	v = s.Amount / c.Amount
+	return v, math.Abs(v) * math.Hypot(s.RelativeUncertainty(), o.RelativeUncertainty())
+}
+
+// UncertainVolume is volume with absolute or relative uncertainty, same as UncertainMass.
+type UncertainVolume struct {
+	Amount      float64    `json:"amount"`
+	Unit        UnitVolume `json:"unit"`
+	Uncertainty float64    `json:"uncertainty"`
+	Relative    bool       `json:"relative,omitzero"`
+}
+
+func NewUncertainVolumeFromString(s string) (*UncertainVolume, error) {
+	return Parser{}.ParseUncertainVolume(s)
+}
+
+// ParseUncertainVolume parses same forms as ParseUncertainMass.
+func (p Parser) ParseUncertainVolume(s string) (*UncertainVolume, error) {
+	a, b, ok := splitUncertainty(s)
+	if !ok {
+		return nil, ErrInvalidUncertainty
+	}
+
+	if u, ok := strings.CutSuffix(b, "%"); ok {
+		m, err := p.ParseVolume(a)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := parseAmount(strings.TrimSpace(u), p.formats(), p.Grouping)
+		if !ok || v < 0 {
+			return nil, ErrInvalidUncertainty
+		}
+		return &UncertainVolume{Amount: m.Amount, Unit: m.Unit, Uncertainty: v / 100, Relative: true}, nil
+	}
+
+	m, errA := p.ParseVolume(a)
+	u, errB := p.ParseVolume(b)
+
+	switch {
+	case errA == nil && errB == nil:
+		u.Amount = u.Convert(m.Unit).Amount
+	case errA == nil && errors.Is(errB, ErrInvalidVolumeUnit):
+		v, ok := parseAmount(b, p.formats(), p.Grouping)
+		if !ok {
+			return nil, ErrInvalidUncertainty
+		}
+		u = &Volume{Amount: v, Unit: m.Unit}
+	case errors.Is(errA, ErrInvalidVolumeUnit) && errB == nil:
+		v, ok := parseAmount(a, p.formats(), p.Grouping)
+		if !ok {
+			return nil, ErrInvalidVolumeAmount
+		}
+		m = &Volume{Amount: v, Unit: u.Unit}
+	case errA != nil:
+		return nil, errA
+	default:
+		return nil, errB
+	}
+
+	if u.Amount < 0 {
+		return nil, ErrInvalidUncertainty
+	}
+	return &UncertainVolume{Amount: m.Amount, Unit: m.Unit, Uncertainty: u.Amount}, nil
+}
+
+func (s UncertainVolume) String() string {
+	if s.Relative {
+		return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() + "±" + formatPercent(s.Uncertainty) + "%"
+	}
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + "±" + strconv.FormatFloat(s.Uncertainty, 'f', -1, 64) + s.Unit.String()
+}
+
+func (s UncertainVolume) Volume() Volume { return Volume{Amount: s.Amount, Unit: s.Unit} }
+
+// AbsoluteUncertainty is uncertainty in unit of volume.
+func (s UncertainVolume) AbsoluteUncertainty() float64 {
+	if s.Relative {
+		return math.Abs(s.Amount) * s.Uncertainty
+	}
+	return s.Uncertainty
+}
+
+// RelativeUncertainty is uncertainty as fraction of amount.
+func (s UncertainVolume) RelativeUncertainty() float64 {
+	if s.Relative {
+		return s.Uncertainty
+	}
+	return s.Uncertainty / math.Abs(s.Amount)
+}
+
+func (s UncertainVolume) withAbsolute(amount, uncertainty float64) UncertainVolume {
+	v := UncertainVolume{Amount: amount, Unit: s.Unit, Uncertainty: uncertainty, Relative: s.Relative}
+	if s.Relative {
+		v.Uncertainty = uncertainty / math.Abs(amount)
+	}
+	return v
+}
+
+// Convert scales absolute uncertainty together with amount, relative uncertainty does not change.
+func (s UncertainVolume) Convert(unit UnitVolume) UncertainVolume {
+	v := UncertainVolume{Amount: s.Volume().Convert(unit).Amount, Unit: unit, Uncertainty: s.Uncertainty, Relative: s.Relative}
+	if !s.Relative {
+		v.Uncertainty = Volume{Amount: s.Uncertainty, Unit: s.Unit}.Convert(unit).Amount
+	}
+	return v
+}
+
+// Add sums amounts and adds absolute uncertainties in quadrature.
+func (s UncertainVolume) Add(o UncertainVolume) UncertainVolume {
+	o = o.Convert(s.Unit)
+	return s.withAbsolute(s.Amount+o.Amount, math.Hypot(s.AbsoluteUncertainty(), o.AbsoluteUncertainty()))
+}
+
+// Sub subtracts amounts and adds absolute uncertainties in quadrature.
+func (s UncertainVolume) Sub(o UncertainVolume) UncertainVolume {
+	o = o.Convert(s.Unit)
+	return s.withAbsolute(s.Amount-o.Amount, math.Hypot(s.AbsoluteUncertainty(), o.AbsoluteUncertainty()))
+}
+
+// Scale multiplies by exact factor, e.g. count of items.
+func (s UncertainVolume) Scale(k float64) UncertainVolume {
+	return s.withAbsolute(s.Amount*k, s.AbsoluteUncertainty()*math.Abs(k))
+}
+
+// Div is ratio of volumes and its absolute uncertainty, relative uncertainties add in quadrature.
+func (s UncertainVolume) Div(o UncertainVolume) (v, uncertainty float64) {
+	c := o.Convert(s.Unit)
+	v = s.Amount / c.Amount
+	return v, math.Abs(v) * math.Hypot(s.RelativeUncertainty(), o.RelativeUncertainty())
+}
+
+// splitUncertainty splits "a ± b" into value and uncertainty, unit after parenthesis "(a ± b) kg" goes to both.
+func splitUncertainty(s string) (a, b string, ok bool) {
+	s = strings.NewReplacer("+/-", "±", "+-", "±").Replace(strings.TrimSpace(s))
+
+	a, b, ok = strings.Cut(s, "±")
+	if !ok || strings.Contains(b, "±") {
+		return "", "", false
+	}
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+
+	if v, ok := strings.CutPrefix(a, "("); ok {
+		i := strings.IndexByte(b, ')')
+		if i == -1 {
+			return "", "", false
+		}
+		unit := strings.TrimSpace(b[i+1:])
+		a, b = strings.TrimSpace(v)+unit, strings.TrimSpace(b[:i])+unit
+	}
+
+	return a, b, a != "" && b != ""
+}
+
+// formatPercent drops float noise of multiplication by 100, so 0.07 is "7" not "7.000000000000001".
+func formatPercent(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100*1e9)/1e9, 'f', -1, 64)
+}
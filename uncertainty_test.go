@@ -0,0 +1,162 @@
+package measurement
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func ExampleUncertainMass_Add() {
+	a, _ := NewUncertainMassFromString("5.0 ± 0.3 kg")
+	b, _ := NewUncertainMassFromString("2000 ± 400 g")
+	fmt.Println(a.Add(*b))
+	// Output: 7±0.5kg
+}
+
+func TestUncertainMass(t *testing.T) {
+	tests := map[string]UncertainMass{
+		"5.0 ± 0.1 kg":   {5, UnitKilograms, 0.1, false},
+		"5.0±0.1kg":      {5, UnitKilograms, 0.1, false},
+		"5.0 +/- 0.1 kg": {5, UnitKilograms, 0.1, false},
+		"5.0 +- 0.1 kg":  {5, UnitKilograms, 0.1, false},
+		"(5.0 ± 0.1) kg": {5, UnitKilograms, 0.1, false},
+		"5 kg ± 0.1 kg":  {5, UnitKilograms, 0.1, false},
+		"5 kg ± 100 g":   {5, UnitKilograms, 0.1, false},
+		"5 kg ± 0.1":     {5, UnitKilograms, 0.1, false},
+		"5 kg ± 2%":      {5, UnitKilograms, 0.02, true},
+		"5kg±2 %":        {5, UnitKilograms, 0.02, true},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			m, err := NewUncertainMassFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *m != v {
+				t.Error(*m, v)
+			}
+		})
+	}
+
+	t.Run("when invalid, then error", func(t *testing.T) {
+		tests := map[string]error{
+			"5kg":         ErrInvalidUncertainty,
+			"5 ± 1 ± 2kg": ErrInvalidUncertainty,
+			"5kg ± -1kg":  ErrInvalidUncertainty,
+			"5kg ± x%":    ErrInvalidUncertainty,
+			"5 ± 1":       ErrInvalidMassUnit,
+			"x ± 1kg":     ErrInvalidMassAmount,
+			"(5 ± 1 kg":   ErrInvalidUncertainty,
+		}
+		for s, e := range tests {
+			if _, err := NewUncertainMassFromString(s); !errors.Is(err, e) {
+				t.Error(s, err, e)
+			}
+		}
+	})
+
+	t.Run("string", func(t *testing.T) {
+		tests := map[UncertainMass]string{
+			{5, UnitKilograms, 0.1, false}: "5±0.1kg",
+			{5, UnitKilograms, 0.02, true}: "5kg±2%",
+			{5, UnitKilograms, 0.07, true}: "5kg±7%",
+			{250, UnitGrams, 0.005, true}:  "250g±0.5%",
+		}
+		for v, s := range tests {
+			if v.String() != s {
+				t.Error(v.String(), s)
+			}
+			if u, err := NewUncertainMassFromString(s); err != nil || math.Abs(u.Uncertainty-v.Uncertainty) > 1e-12 {
+				t.Error(s, u, err)
+			}
+		}
+	})
+
+	t.Run("convert", func(t *testing.T) {
+		if v := (UncertainMass{5, UnitKilograms, 0.1, false}).Convert(UnitGrams); v != (UncertainMass{5000, UnitGrams, 100, false}) {
+			t.Error(v)
+		}
+		if v := (UncertainMass{5, UnitKilograms, 0.02, true}).Convert(UnitGrams); v != (UncertainMass{5000, UnitGrams, 0.02, true}) {
+			t.Error(v)
+		}
+		v := (UncertainMass{1, UnitPounds, 0.1, false}).Convert(UnitGrams)
+		if math.Abs(v.Amount-453.59237) > 1e-9 || math.Abs(v.Uncertainty-45.359237) > 1e-9 {
+			t.Error(v)
+		}
+	})
+
+	t.Run("propagation", func(t *testing.T) {
+		a := UncertainMass{5, UnitKilograms, 0.3, false}
+		b := UncertainMass{2000, UnitGrams, 400, false}
+
+		if v := a.Add(b); v != (UncertainMass{7, UnitKilograms, 0.5, false}) {
+			t.Error(v)
+		}
+		if v := a.Sub(b); v != (UncertainMass{3, UnitKilograms, 0.5, false}) {
+			t.Error(v)
+		}
+		if v := a.Scale(-2); v != (UncertainMass{-10, UnitKilograms, 0.6, false}) {
+			t.Error(v)
+		}
+
+		r := UncertainMass{10, UnitKilograms, 0.03, true}
+		if v := r.Scale(3); v.Amount != 30 || !v.Relative || math.Abs(v.Uncertainty-0.03) > 1e-12 {
+			t.Error(v)
+		}
+		if v := r.Add(UncertainMass{10, UnitKilograms, 0.4, false}); v.Amount != 20 || !v.Relative || math.Abs(v.Uncertainty-0.025) > 1e-12 {
+			t.Error(v)
+		}
+
+		v, u := (UncertainMass{6, UnitKilograms, 0.03, true}).Div(UncertainMass{2000, UnitGrams, 80, false})
+		if v != 3 || math.Abs(u-0.15) > 1e-12 {
+			t.Error(v, u)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(UncertainMass{5, UnitKilograms, 0.1, false})
+		if err != nil || string(b) != `{"amount":5,"unit":"kg","uncertainty":0.1}` {
+			t.Error(string(b), err)
+		}
+		b, err = json.Marshal(UncertainMass{5, UnitKilograms, 0.02, true})
+		if err != nil || string(b) != `{"amount":5,"unit":"kg","uncertainty":0.02,"relative":true}` {
+			t.Error(string(b), err)
+		}
+	})
+}
+
+func TestUncertainVolume(t *testing.T) {
+	tests := map[string]UncertainVolume{
+		"330 ± 5 ml":      {330, UnitMilliLiters, 5, false},
+		"1 l ± 10 ml":     {1, UnitLiters, 0.01, false},
+		"(1.5 +/- 0.1) l": {1.5, UnitLiters, 0.1, false},
+		"2 l ± 1%":        {2, UnitLiters, 0.01, true},
+	}
+	for s, v := range tests {
+		t.Run(s, func(t *testing.T) {
+			m, err := NewUncertainVolumeFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *m != v {
+				t.Error(*m, v)
+			}
+		})
+	}
+
+	t.Run("propagation", func(t *testing.T) {
+		a := UncertainVolume{1, UnitLiters, 0.003, false}
+		b := UncertainVolume{500, UnitMilliLiters, 4, false}
+		if v := a.Add(b); v.Amount != 1.5 || math.Abs(v.Uncertainty-0.005) > 1e-12 {
+			t.Error(v)
+		}
+		if v := b.Convert(UnitLiters); v != (UncertainVolume{0.5, UnitLiters, 0.004, false}) {
+			t.Error(v)
+		}
+		if v := b.Scale(6).String(); v != "3000±24ml" {
+			t.Error(v)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package measurement
+
+import "strings"
+
+// SymbolStyle is how unit symbols are written.
+type SymbolStyle uint8
+
+const (
+	SymbolStyleASCII   SymbolStyle = iota // text encoding of units, e.g. mcg, m3
+	SymbolStyleUnicode                    // typographic symbols, e.g. µg, m³
+)
+
+var unicodeSymbolsMass = map[UnitMass]string{
+	UnitMicrograms: "µg",
+}
+
+var unicodeSymbolsVolume = map[UnitVolume]string{
+	UnitCubicMilliMeters: "mm³",
+	UnitCubicCentiMeters: "cm³",
+	UnitCubicDeciMeters:  "dm³",
+	UnitCubicFeet:        "ft³",
+	UnitCubicInches:      "in³",
+	UnitCubicMeters:      "m³",
+	UnitCubicKiloMeters:  "km³",
+	UnitCubicMiles:       "mi³",
+	UnitCubicYards:       "yd³",
+}
+
+var unicodeSymbolsArea = map[UnitArea]string{
+	UnitSquareMilliMeters: "mm²",
+	UnitSquareCentiMeters: "cm²",
+	UnitSquareDeciMeters:  "dm²",
+	UnitSquareMeters:      "m²",
+	UnitSquareKiloMeters:  "km²",
+	UnitSquareInches:      "in²",
+	UnitSquareFeet:        "ft²",
+	UnitSquareYards:       "yd²",
+	UnitSquareMiles:       "mi²",
+}
+
+var unicodeSymbolsTemperature = map[UnitTemperature]string{
+	UnitCelsius:    "°C",
+	UnitFahrenheit: "°F",
+	UnitRankine:    "°R",
+}
+
+var unicodeSymbolsTime = map[UnitTime]string{
+	UnitMicroSeconds: "µs",
+}
+
+var unicodeSymbolsDensity = map[UnitDensity]string{
+	UnitKiloGramsPerCubicMeter:  "kg/m³",
+	UnitGramsPerCubicCentiMeter: "g/cm³",
+	UnitPoundsPerCubicFoot:      "lb/ft³",
+}
+
+var unicodeSymbolsFlowRate = map[UnitFlowRate]string{
+	UnitCubicMetersPerSecond: "m³/s",
+	UnitCubicMetersPerHour:   "m³/h",
+	UnitCubicFeetPerMinute:   "ft³/min",
+}
+
+// compatibilitySymbols are CJK compatibility characters of units and their text encoding.
+var compatibilitySymbols = map[string]string{
+	"㎍": "mcg",
+	"㎟": "mm2",
+	"㎠": "cm2",
+	"㎡": "m2",
+	"㎢": "km2",
+	"㎎": "mg",
+	"㎏": "kg",
+	"㎖": "ml",
+	"㎗": "dl",
+	"㎘": "kl",
+	"㎣": "mm3",
+	"㎤": "cm3",
+	"㎥": "m3",
+	"㎦": "km3",
+	"㏄": "cm3",
+	"㌘": "g",
+	"㌕": "kg",
+	"㍑": "l",
+}
+
+// unicodeReplacer rewrites typographic and compatibility symbols to text encoding of units.
+var unicodeReplacer = func() *strings.Replacer {
+	pairs := []string{
+		"\u00b5", "mc", // micro sign
+		"\u03bc", "mc", // greek small letter mu
+		"ℓ", "l",
+		"²", "2",
+		"³", "3",
+		"℃", "C",
+		"℉", "F",
+		"\u212a", "K", // kelvin sign
+	}
+	for k, v := range compatibilitySymbols {
+		pairs = append(pairs, k, v)
+	}
+	return strings.NewReplacer(pairs...)
+}()
+
+// normalizeUnicode rewrites s so that units and amounts are in their text encoding.
+// Fullwidth forms (１００ｇ) are mapped to ASCII.
+func normalizeUnicode(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '\uff01' && r <= '\uff5e':
+			return r - 0xfee0
+		case r == '\u3000':
+			return ' '
+		default:
+			return r
+		}
+	}, unicodeReplacer.Replace(s))
+}
@@ -0,0 +1,138 @@
+package measurement
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleVolume_StringStyle() {
+	v := Volume{Amount: 2, Unit: UnitCubicMeters}
+	fmt.Println(v.StringStyle(SymbolStyleASCII), v.StringStyle(SymbolStyleUnicode))
+	// Output: 2m3 2m³
+}
+
+func TestParser_Unicode(t *testing.T) {
+	var p Parser
+
+	t.Run("mass", func(t *testing.T) {
+		tests := map[string]Mass{
+			"250 µg": {Amount: 250, Unit: UnitMicrograms},
+			"250 μg": {Amount: 250, Unit: UnitMicrograms},
+			"250㎍":   {Amount: 250, Unit: UnitMicrograms},
+			"1.5㎏":   {Amount: 1.5, Unit: UnitKilograms},
+			"１００ｇ":   {Amount: 100, Unit: UnitGrams},
+			"１．５　ｋｇ": {Amount: 1.5, Unit: UnitKilograms},
+		}
+		for s, v := range tests {
+			t.Run(s, func(t *testing.T) {
+				m, err := p.ParseMass(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *m != v {
+					t.Error(*m, v)
+				}
+			})
+		}
+	})
+
+	t.Run("volume", func(t *testing.T) {
+		tests := map[string]Volume{
+			"500 ㎖":   {Amount: 500, Unit: UnitMilliLiters},
+			"1 ℓ":     {Amount: 1, Unit: UnitLiters},
+			"250 mℓ":  {Amount: 250, Unit: UnitMilliLiters},
+			"2 m³":    {Amount: 2, Unit: UnitCubicMeters},
+			"10 cm³":  {Amount: 10, Unit: UnitCubicCentiMeters},
+			"10㏄":     {Amount: 10, Unit: UnitCubicCentiMeters},
+			"３３０ｍｌ":   {Amount: 330, Unit: UnitMilliLiters},
+			"1.5 ft³": {Amount: 1.5, Unit: UnitCubicFeet},
+		}
+		for s, v := range tests {
+			t.Run(s, func(t *testing.T) {
+				m, err := p.ParseVolume(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if *m != v {
+					t.Error(*m, v)
+				}
+			})
+		}
+	})
+
+	t.Run("when language spelled in unicode, then matched", func(t *testing.T) {
+		p := Parser{Languages: []Language{{Decimal: ',', Volume: map[string]UnitVolume{"м³": UnitCubicMeters}}}}
+		if v, err := p.ParseVolume("1,5 м³"); err != nil || *v != (Volume{Amount: 1.5, Unit: UnitCubicMeters}) {
+			t.Error(v, err)
+		}
+	})
+}
+
+func TestStringStyle(t *testing.T) {
+	t.Run("unicode", func(t *testing.T) {
+		if s := (Mass{Amount: 250, Unit: UnitMicrograms}).StringStyle(SymbolStyleUnicode); s != "250µg" {
+			t.Error(s)
+		}
+		if s := (Volume{Amount: 10, Unit: UnitCubicCentiMeters}).StringStyle(SymbolStyleUnicode); s != "10cm³" {
+			t.Error(s)
+		}
+		if s := (Mass{Amount: 1, Unit: UnitKilograms}).StringStyle(SymbolStyleUnicode); s != "1kg" {
+			t.Error(s)
+		}
+	})
+
+	t.Run("ascii is same as String", func(t *testing.T) {
+		for _, u := range UnitMassAll {
+			if m := (Mass{Amount: 1.5, Unit: u}); m.StringStyle(SymbolStyleASCII) != m.String() {
+				t.Error(u)
+			}
+		}
+		for _, u := range UnitVolumeAll {
+			if v := (Volume{Amount: 1.5, Unit: u}); v.StringStyle(SymbolStyleASCII) != v.String() {
+				t.Error(u)
+			}
+		}
+	})
+
+	t.Run("unicode round trip", func(t *testing.T) {
+		var p Parser
+		for _, u := range UnitMassAll {
+			m := Mass{Amount: 1.5, Unit: u}
+			if v, err := p.ParseMass(m.StringStyle(SymbolStyleUnicode)); err != nil || *v != m {
+				t.Error(u, v, err)
+			}
+		}
+		for _, u := range UnitVolumeAll {
+			m := Volume{Amount: 1.5, Unit: u}
+			if v, err := p.ParseVolume(m.StringStyle(SymbolStyleUnicode)); err != nil || *v != m {
+				t.Error(u, v, err)
+			}
+		}
+	})
+}
+
+func TestNewFromString_Unicode(t *testing.T) {
+	tests := map[string]struct {
+		parse func(string) (fmt.Stringer, error)
+		want  string
+	}{
+		"250 µg":    {func(s string) (fmt.Stringer, error) { return NewMassFromString(s) }, "250mcg"},
+		"１００ｇ":      {func(s string) (fmt.Stringer, error) { return NewMassFromString(s) }, "100g"},
+		"330 ㎖":     {func(s string) (fmt.Stringer, error) { return NewVolumeFromString(s) }, "330ml"},
+		"5 ｃｍ":      {func(s string) (fmt.Stringer, error) { return NewLengthFromString(s) }, "5cm"},
+		"12 m²":     {func(s string) (fmt.Stringer, error) { return NewAreaFromString(s) }, "12m2"},
+		"5µs":       {func(s string) (fmt.Stringer, error) { return NewTimeFromString(s) }, "5us"},
+		"２５０ｋｃａｌ":   {func(s string) (fmt.Stringer, error) { return NewEnergyFromString(s) }, "250kcal"},
+		"２ｋＷ":       {func(s string) (fmt.Stringer, error) { return NewPowerFromString(s) }, "2kW"},
+		"２．２ ｂａｒ":   {func(s string) (fmt.Stringer, error) { return NewPressureFromString(s) }, "2.2bar"},
+		"２５ ｋｍ/ｈ":   {func(s string) (fmt.Stringer, error) { return NewSpeedFromString(s) }, "25km/h"},
+		"997 kg/m³": {func(s string) (fmt.Stringer, error) { return NewDensityFromString(s) }, "997kg/m3"},
+		"2 m³/h":    {func(s string) (fmt.Stringer, error) { return NewFlowRateFromString(s) }, "2m3/h"},
+		"5 ｍｇ/ｌ":    {func(s string) (fmt.Stringer, error) { return NewConcentrationFromString(s) }, "5mg/l"},
+	}
+	for s, tc := range tests {
+		if v, err := tc.parse(s); err != nil || v.String() != tc.want {
+			t.Error(s, v, err)
+		}
+	}
+}
@@ -16,15 +16,103 @@ func TestAllUnitUnique(t *testing.T) {
 		unitsVolume = append(unitsVolume, q.String())
 	}
 
-	all := make(map[string]bool, len(unitsMass)+len(unitsVolume))
+	var unitsLength []string
+	for _, q := range UnitLengthAll {
+		unitsLength = append(unitsLength, q.String())
+	}
+
+	var unitsArea []string
+	for _, q := range UnitAreaAll {
+		unitsArea = append(unitsArea, q.String())
+	}
+
+	var unitsTemperature []string
+	for _, q := range UnitTemperatureAll {
+		unitsTemperature = append(unitsTemperature, q.String())
+	}
+
+	var unitsTime []string
+	for _, q := range UnitTimeAll {
+		unitsTime = append(unitsTime, q.String())
+	}
+
+	var unitsEnergy []string
+	for _, q := range UnitEnergyAll {
+		unitsEnergy = append(unitsEnergy, q.String())
+	}
+
+	var unitsPower []string
+	for _, q := range UnitPowerAll {
+		unitsPower = append(unitsPower, q.String())
+	}
+
+	var unitsPressure []string
+	for _, q := range UnitPressureAll {
+		unitsPressure = append(unitsPressure, q.String())
+	}
+
+	var unitsSpeed []string
+	for _, q := range UnitSpeedAll {
+		unitsSpeed = append(unitsSpeed, q.String())
+	}
+
+	var unitsDensity []string
+	for _, q := range UnitDensityAll {
+		unitsDensity = append(unitsDensity, q.String())
+	}
+
+	var unitsFlowRate []string
+	for _, q := range UnitFlowRateAll {
+		unitsFlowRate = append(unitsFlowRate, q.String())
+	}
+
+	var unitsConcentration []string
+	for _, q := range UnitConcentrationAll {
+		unitsConcentration = append(unitsConcentration, q.String())
+	}
+
+	all := make(map[string]bool, len(unitsMass)+len(unitsVolume)+len(unitsLength)+len(unitsArea)+len(unitsTemperature)+len(unitsTime)+len(unitsEnergy)+len(unitsPower)+len(unitsPressure)+len(unitsSpeed)+len(unitsDensity)+len(unitsFlowRate)+len(unitsConcentration))
 	for _, q := range unitsMass {
 		all[q] = true
 	}
 	for _, q := range unitsVolume {
 		all[q] = true
 	}
+	for _, q := range unitsLength {
+		all[q] = true
+	}
+	for _, q := range unitsArea {
+		all[q] = true
+	}
+	for _, q := range unitsTemperature {
+		all[q] = true
+	}
+	for _, q := range unitsTime {
+		all[q] = true
+	}
+	for _, q := range unitsEnergy {
+		all[q] = true
+	}
+	for _, q := range unitsPower {
+		all[q] = true
+	}
+	for _, q := range unitsPressure {
+		all[q] = true
+	}
+	for _, q := range unitsSpeed {
+		all[q] = true
+	}
+	for _, q := range unitsDensity {
+		all[q] = true
+	}
+	for _, q := range unitsFlowRate {
+		all[q] = true
+	}
+	for _, q := range unitsConcentration {
+		all[q] = true
+	}
 
-	if len(all) != len(unitsMass)+len(unitsVolume) {
+	if len(all) != len(unitsMass)+len(unitsVolume)+len(unitsLength)+len(unitsArea)+len(unitsTemperature)+len(unitsTime)+len(unitsEnergy)+len(unitsPower)+len(unitsPressure)+len(unitsSpeed)+len(unitsDensity)+len(unitsFlowRate)+len(unitsConcentration) {
 		t.Error("duplicates found")
 	}
 }
@@ -17,6 +17,8 @@ type Volume struct {
 }
 
 func NewVolumeFromString(s string) (*Volume, error) {
+	s = normalizeUnicode(s)
+
 	var unit UnitVolume
 	var maxl int
 	for _, u := range UnitVolumeAll {
@@ -30,12 +32,12 @@ func NewVolumeFromString(s string) (*Volume, error) {
 		return nil, ErrInvalidVolumeUnit
 	}
 
-	lenAmount := len(s) - len(unit.String())
-	if lenAmount <= 0 {
+	a := strings.TrimSpace(s[:len(s)-len(unit.String())])
+	if a == "" {
 		return nil, ErrInvalidVolumeAmount
 	}
 
-	amount, err := strconv.ParseFloat(s[:lenAmount], 64)
+	amount, err := strconv.ParseFloat(a, 64)
 	if err != nil {
 		return nil, err
 	}
@@ -45,6 +47,10 @@ func NewVolumeFromString(s string) (*Volume, error) {
 
 func (s Volume) String() string { return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.String() }
 
+func (s Volume) StringStyle(style SymbolStyle) string {
+	return strconv.FormatFloat(s.Amount, 'f', -1, 64) + s.Unit.Symbol(style)
+}
+
 func (s *Volume) IsZero() bool {
 	if s == nil {
 		return true
@@ -65,6 +71,27 @@ func (s Volume) Convert(unit UnitVolume) Volume {
 	return Volume{Amount: amount / factor, Unit: unit}
 }
 
+// Add sums volumes in unit of s, or in finer unit when both are in same ladder, so 1l + 250ml is exactly 1250ml.
+func (s Volume) Add(o Volume) Volume {
+	unit := s.Unit
+	for _, q := range unitVolumeLadders {
+		if u, ok := q.ladder.finer(s.Unit, o.Unit); ok {
+			unit = u
+			break
+		}
+	}
+	return Volume{Amount: s.Convert(unit).Amount + o.Convert(unit).Amount, Unit: unit}
+}
+
+// Sub subtracts volumes in same unit as Add.
+func (s Volume) Sub(o Volume) Volume { return s.Add(o.Scale(-1)) }
+
+// Scale multiplies by number, e.g. count of items.
+func (s Volume) Scale(k float64) Volume { return Volume{Amount: s.Amount * k, Unit: s.Unit} }
+
+// Div is how many o are in s, e.g. 1l / 250ml is 4.
+func (s Volume) Div(o Volume) float64 { return s.Amount / o.Convert(s.Unit).Amount }
+
 func TryConvertExactVolume[T int32 | int64 | float32 | float64](amount T, from, to UnitVolume) (v T, ok bool) {
 	for _, q := range unitVolumeLadders {
 		if v, ok := convertByLadder(amount, from, to, q.ladder); ok {
@@ -74,6 +101,60 @@ func TryConvertExactVolume[T int32 | int64 | float32 | float64](amount T, from,
 	return 0, false
 }
 
+// TryConvertExactVolumeRational converts fractional amount along ladder, e.g. 1/3 cup is exactly 16 tsp.
+func TryConvertExactVolumeRational(amount Rational, from, to UnitVolume) (v Rational, ok bool) {
+	if from == to {
+		return amount, true
+	}
+	for _, q := range unitVolumeLadders {
+		if f, ok := q.ladder.factor(from, to); ok {
+			return amount.mul(f)
+		}
+	}
+	return Rational{}, false
+}
+
+// VolumeRational is volume with exact fractional amount, as written in recipes and US packaging.
+type VolumeRational struct {
+	Amount Rational   `json:"amount"`
+	Unit   UnitVolume `json:"unit"`
+}
+
+// NewVolumeRationalFromString parses recipe amounts with English unit aliases, e.g. "1 1/2 cups" or "½ tsp".
+func NewVolumeRationalFromString(s string) (*VolumeRational, error) {
+	return ExtractorParser.ParseVolumeRational(s)
+}
+
+// ParseVolumeRational reads amount as exact fraction and unit in spellings of parser.
+func (p Parser) ParseVolumeRational(s string) (*VolumeRational, error) {
+	a, u := splitAmount(strings.TrimSpace(normalizeUnicode(s)))
+	matches, err := matchUnit(p, u, p.volumeSymbols(), ErrInvalidVolumeUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := NewRationalFromString(a)
+	if err != nil {
+		return nil, ErrInvalidVolumeAmount
+	}
+
+	return &VolumeRational{Amount: amount, Unit: matches[0].unit}, nil
+}
+
+func (s VolumeRational) String() string { return s.Amount.String() + s.Unit.String() }
+
+func (s VolumeRational) Volume() Volume { return Volume{Amount: s.Amount.Float64(), Unit: s.Unit} }
+
+func (s VolumeRational) Convert(unit UnitVolume) (VolumeRational, bool) {
+	v, ok := TryConvertExactVolumeRational(s.Amount, s.Unit, unit)
+	return VolumeRational{Amount: v, Unit: unit}, ok
+}
+
+// StringFraction formats amount as nearest fraction with given denominators, e.g. "1 1/2cup".
+func (s Volume) StringFraction(denominators ...int64) string {
+	return NearestRational(s.Amount, denominators...).String() + s.Unit.String()
+}
+
 // skipping `metric cup` and `acre-feet`, they are not in any ladder.
 
 type UnitVolume uint8
@@ -113,6 +194,13 @@ const (
 	UnitImperialTeaspoons                     // json:"imptsp"
 )
 
+func (s UnitVolume) Symbol(style SymbolStyle) string {
+	if v, ok := unicodeSymbolsVolume[s]; ok && style == SymbolStyleUnicode {
+		return v
+	}
+	return s.String()
+}
+
 var UnitVolumeAll = [...]UnitVolume{
 	UnitMilliLiters,
 	UnitCentiLiters,
@@ -226,3 +226,28 @@ func TestTryConvertExactVolume(t *testing.T) {
 		}
 	})
 }
+
+func TestVolume_Add(t *testing.T) {
+	tests := []struct {
+		a, b, sum Volume
+	}{
+		{Volume{1, UnitLiters}, Volume{250, UnitMilliLiters}, Volume{1250, UnitMilliLiters}},
+		{Volume{1, UnitCups}, Volume{1, UnitTablespoons}, Volume{17, UnitTablespoons}},
+		{Volume{1, UnitCubicMeters}, Volume{1, UnitCubicMeters}, Volume{2, UnitCubicMeters}},
+	}
+	for _, tt := range tests {
+		if v := tt.a.Add(tt.b); v != tt.sum {
+			t.Error(tt.a, tt.b, v, tt.sum)
+		}
+	}
+
+	if v := (Volume{1, UnitLiters}).Sub(Volume{1, UnitPints}); v.Unit != UnitLiters || math.Abs(v.Amount-0.526824) > 1e-6 {
+		t.Error(v)
+	}
+	if v := (Volume{330, UnitMilliLiters}).Scale(6); v != (Volume{1980, UnitMilliLiters}) {
+		t.Error(v)
+	}
+	if v := (Volume{1, UnitLiters}).Div(Volume{250, UnitMilliLiters}); v != 4 {
+		t.Error(v)
+	}
+}